@@ -18,14 +18,67 @@ package chunkers
 
 import (
 	"bufio"
-	"errors"
+	"context"
+	"fmt"
 	"io"
+	"sync"
 )
 
+// KeySize is the length of the secret key accepted in ChunkerOpts.Key.
+const KeySize = 32
+
 type ChunkerOpts struct {
 	MinSize    int
 	MaxSize    int
 	NormalSize int
+
+	// Key, when set, selects a keyed variant of the algorithm so that chunk
+	// boundaries can't be predicted by someone who doesn't know the key.
+	Key []byte
+
+	// Polynomial selects the irreducible polynomial of the rabin algorithm,
+	// zero selects its default.
+	Polynomial uint64
+
+	// NormalizationLevel spreads the masks of the fastcdc algorithms around
+	// log2(NormalSize) by as many bits, from 1 to 3 as in the FastCDC paper.
+	// Chunk sizes get closer to NormalSize as the level increases. Zero
+	// selects the default level, NormalizationNone disables normalization.
+	NormalizationLevel int
+
+	// WindowSize and Seed configure the rolling hash of the buzhash
+	// algorithm, a zero WindowSize selects its default.
+	WindowSize int
+	Seed       uint32
+
+	// Extra holds the options of algorithms that have no field above, it is
+	// set with WithExtra.
+	Extra map[string]any
+}
+
+// NormalizationNone is the NormalizationLevel of level 0 in the FastCDC paper,
+// where the same mask is used before and after NormalSize.
+const NormalizationNone = -1
+
+// OptionsError is returned when an option is outside of the range accepted
+// by an algorithm. For Key, Value holds the length of the key. Reason, when
+// set, explains why a value within the range is rejected.
+type OptionsError struct {
+	Field  string
+	Value  int
+	Min    int
+	Max    int
+	Reason string
+}
+
+func (e *OptionsError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+	}
+	if e.Min == e.Max {
+		return fmt.Sprintf("invalid %s: %d, must be %d", e.Field, e.Value, e.Min)
+	}
+	return fmt.Sprintf("invalid %s: %d, must be %d <= %s <= %d", e.Field, e.Value, e.Min, e.Field, e.Max)
 }
 
 type ChunkerImplementation interface {
@@ -34,7 +87,28 @@ type ChunkerImplementation interface {
 	Algorithm(*ChunkerOpts, []byte, int) int
 }
 
+// Versioner is implemented by algorithms that identify the revision of their
+// boundary selection, which changes whenever the same input and options would
+// produce different chunks.
+type Versioner interface {
+	Version() string
+}
+
+// Resolver is implemented by algorithms that derive options left at zero from
+// the others. Resolve sets them on validated options, so that chunkers report
+// and configs record the values in use.
+type Resolver interface {
+	Resolve(*ChunkerOpts)
+}
+
+func resolveOptions(implementation ChunkerImplementation, opts *ChunkerOpts) {
+	if resolver, ok := implementation.(Resolver); ok {
+		resolver.Resolve(opts)
+	}
+}
+
 type Chunker struct {
+	algorithm      string
 	rd             *bufio.Reader
 	options        *ChunkerOpts
 	implementation ChunkerImplementation
@@ -58,30 +132,75 @@ func (c *Chunker) NormalSize() int {
 	return c.options.NormalSize
 }
 
-var chunkers map[string]func() ChunkerImplementation = make(map[string]func() ChunkerImplementation)
+func (c *Chunker) NormalizationLevel() int {
+	return c.options.NormalizationLevel
+}
+
+// Version returns the boundary version of algorithm so that it can be stored
+// alongside chunked data, or an empty string if the algorithm doesn't report
+// one.
+func Version(algorithm string) (string, error) {
+	return DefaultRegistry.Version(algorithm)
+}
+
+// Version is like the package-level Version for the algorithms of r.
+func (r *Registry) Version(algorithm string) (string, error) {
+	implementationAllocator, err := r.lookup(algorithm)
+	if err != nil {
+		return "", err
+	}
 
-func Register(name string, implementation func() ChunkerImplementation) error {
-	if _, exists := chunkers[name]; exists {
-		return errors.New("algorithm already registered")
+	if versioner, ok := implementationAllocator().(Versioner); ok {
+		return versioner.Version(), nil
 	}
-	chunkers[name] = implementation
-	return nil
+	return "", nil
 }
 
-func NewChunker(algorithm string, reader io.Reader, opts *ChunkerOpts) (*Chunker, error) {
-	var implementationAllocator func() ChunkerImplementation
+// ValidateOptions checks opts against the constraints of algorithm without
+// creating a chunker, a nil opts checks the default options.
+func ValidateOptions(algorithm string, opts *ChunkerOpts) error {
+	return DefaultRegistry.ValidateOptions(algorithm, opts)
+}
 
-	implementationAllocator, exists := chunkers[algorithm]
-	if !exists {
-		return nil, errors.New("unknown algorithm")
+// ValidateOptions is like the package-level ValidateOptions for the
+// algorithms of r.
+func (r *Registry) ValidateOptions(algorithm string, opts *ChunkerOpts) error {
+	implementationAllocator, err := r.lookup(algorithm)
+	if err != nil {
+		return err
 	}
 
+	implementation := implementationAllocator()
 	if opts == nil {
-		opts = implementationAllocator().DefaultOptions()
+		opts = implementation.DefaultOptions()
+	}
+	return implementation.Validate(opts)
+}
+
+// NewChunker returns a chunker for algorithm reading from reader, with the
+// default options of algorithm modified by options. Passing a single
+// *ChunkerOpts, or nil for the defaults, is also supported.
+func NewChunker(algorithm string, reader io.Reader, options ...Option) (*Chunker, error) {
+	return DefaultRegistry.NewChunker(algorithm, reader, options...)
+}
+
+// NewChunker is like the package-level NewChunker for the algorithms of r.
+func (r *Registry) NewChunker(algorithm string, reader io.Reader, options ...Option) (*Chunker, error) {
+	implementationAllocator, err := r.lookup(algorithm)
+	if err != nil {
+		return nil, err
 	}
 
+	implementation := implementationAllocator()
+	opts := applyOptions(implementation, options)
+	if err := implementation.Validate(opts); err != nil {
+		return nil, err
+	}
+	resolveOptions(implementation, opts)
+
 	chunker := &Chunker{}
-	chunker.implementation = implementationAllocator()
+	chunker.algorithm = algorithm
+	chunker.implementation = implementation
 	chunker.options = opts
 	chunker.rd = bufio.NewReaderSize(reader, int(chunker.options.MaxSize)*2)
 
@@ -92,6 +211,16 @@ func NewChunker(algorithm string, reader io.Reader, opts *ChunkerOpts) (*Chunker
 	return chunker, nil
 }
 
+// Reset discards any buffered data and makes the chunker read from reader,
+// reusing its buffer and implementation.
+func (chunker *Chunker) Reset(reader io.Reader) {
+	chunker.rd.Reset(reader)
+	chunker.cutpoint = 0
+}
+
+// Next returns the next chunk, which is only valid until the following call
+// to Next. Chunks are always returned with a nil error, io.EOF is returned
+// alone once the last chunk has been returned.
 func (chunker *Chunker) Next() ([]byte, error) {
 	if chunker.cutpoint != 0 {
 		// Discard is guaranteed to succeed here, do not check for error
@@ -112,53 +241,161 @@ func (chunker *Chunker) Next() ([]byte, error) {
 	cutpoint := chunker.implementation.Algorithm(chunker.options, data, n)
 	chunker.cutpoint = cutpoint
 
-	if cutpoint < chunker.minSize {
-		return data[:cutpoint], io.EOF
-	}
-
 	return data[:cutpoint], nil
 }
 
+// NextContext is like Next but returns ctx.Err() if ctx is done before the
+// next chunk is read.
+func (chunker *Chunker) NextContext(ctx context.Context) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return chunker.Next()
+}
+
+// Copy writes each chunk to dst with a separate call to Write, it returns the
+// number of bytes written and a nil error once all chunks have been written.
 func (chunker *Chunker) Copy(dst io.Writer) (int64, error) {
+	return chunker.CopyContext(context.Background(), dst)
+}
+
+// CopyContext is like Copy but stops with ctx.Err() when ctx is done,
+// cancellation is checked between chunks.
+func (chunker *Chunker) CopyContext(ctx context.Context, dst io.Writer) (int64, error) {
 	nbytes := int64(0)
 	for {
-		chunk, err := chunker.Next()
-		if err != nil && err != io.EOF {
+		chunk, err := chunker.NextContext(ctx)
+		if err == io.EOF {
+			return nbytes, nil
+		}
+		if err != nil {
 			return nbytes, err
 		}
 
-		if len(chunk) != 0 {
-			if _, werr := dst.Write(chunk); werr != nil {
-				return nbytes, werr
-			}
-		}
-		if err == io.EOF {
-			break
+		n, err := dst.Write(chunk)
+		nbytes += int64(n)
+		if err != nil {
+			return nbytes, err
 		}
-
-		nbytes += int64(len(chunk))
 	}
-	return nbytes, io.EOF
 }
 
+// Split calls callback for each chunk with its offset in the input, it
+// returns nil once all chunks have been passed to callback.
 func (chunker *Chunker) Split(callback func(offset, length uint, chunk []byte) error) error {
+	return chunker.SplitContext(context.Background(), callback)
+}
+
+// SplitContext is like Split but stops with ctx.Err() when ctx is done,
+// cancellation is checked between chunks.
+func (chunker *Chunker) SplitContext(ctx context.Context, callback func(offset, length uint, chunk []byte) error) error {
 	offset := uint(0)
 	for {
-		chunk, err := chunker.Next()
-		if err != nil && err != io.EOF {
+		chunk, err := chunker.NextContext(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
 			return err
 		}
 
-		if len(chunk) != 0 {
-			if err = callback(offset, uint(len(chunk)), chunk); err != nil {
-				return err
-			}
+		if err = callback(offset, uint(len(chunk)), chunk); err != nil {
+			return err
 		}
+		offset += uint(len(chunk))
+	}
+}
 
-		if err == io.EOF {
-			break
+// Pool caches chunkers by algorithm and options so that their buffers are
+// reused across inputs. The zero Pool is ready for use.
+type Pool struct {
+	// Registry looks up algorithms, nil selects DefaultRegistry.
+	Registry *Registry
+
+	mu    sync.Mutex
+	pools map[poolKey]*sync.Pool
+}
+
+type poolKey struct {
+	algorithm  string
+	minSize    int
+	maxSize    int
+	normalSize int
+	key        string
+	polynomial uint64
+	level      int
+	windowSize int
+	seed       uint32
+	extra      string
+}
+
+func newPoolKey(algorithm string, opts *ChunkerOpts) poolKey {
+	return poolKey{
+		algorithm:  algorithm,
+		minSize:    opts.MinSize,
+		maxSize:    opts.MaxSize,
+		normalSize: opts.NormalSize,
+		key:        string(opts.Key),
+		polynomial: opts.Polynomial,
+		level:      opts.NormalizationLevel,
+		windowSize: opts.WindowSize,
+		seed:       opts.Seed,
+		extra:      extraKey(opts.Extra),
+	}
+}
+
+// extraKey formats extra with its keys sorted, values are compared by their
+// formatting.
+func extraKey(extra map[string]any) string {
+	if len(extra) == 0 {
+		return ""
+	}
+	return fmt.Sprint(extra)
+}
+
+func (p *Pool) registry() *Registry {
+	if p.Registry == nil {
+		return DefaultRegistry
+	}
+	return p.Registry
+}
+
+func (p *Pool) pool(key poolKey) *sync.Pool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.pools == nil {
+		p.pools = make(map[poolKey]*sync.Pool)
+	}
+	pool, exists := p.pools[key]
+	if !exists {
+		pool = &sync.Pool{}
+		p.pools[key] = pool
+	}
+	return pool
+}
+
+// Get returns a chunker for algorithm and opts reading from reader, reusing
+// a chunker previously returned to the pool with Put if one is available.
+func (p *Pool) Get(algorithm string, reader io.Reader, opts *ChunkerOpts) (*Chunker, error) {
+	registry := p.registry()
+	if opts == nil {
+		implementationAllocator, err := registry.lookup(algorithm)
+		if err != nil {
+			return nil, err
 		}
-		offset += uint(len(chunk))
+		opts = implementationAllocator().DefaultOptions()
+	}
+
+	if chunker, ok := p.pool(newPoolKey(algorithm, opts)).Get().(*Chunker); ok {
+		chunker.Reset(reader)
+		return chunker, nil
 	}
-	return nil
+	return registry.NewChunker(algorithm, reader, opts)
+}
+
+// Put returns chunker to the pool, it must not be used afterwards.
+func (p *Pool) Put(chunker *Chunker) {
+	chunker.Reset(nil)
+	p.pool(newPoolKey(chunker.algorithm, chunker.options)).Put(chunker)
 }
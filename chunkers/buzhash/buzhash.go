@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package buzhash
+
+import (
+	"fmt"
+	"math/bits"
+	"sync"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/internal/keyed"
+)
+
+func init() {
+	chunkers.MustRegister("buzhash", newBuzhash)
+}
+
+// defaultWindowSize is borg's default hash window size.
+const defaultWindowSize = 4095
+
+// WithWindowSize sets the number of bytes covered by the rolling hash.
+func WithWindowSize(size int) chunkers.Option {
+	return chunkers.OptionFunc(func(opts *chunkers.ChunkerOpts) { opts.WindowSize = size })
+}
+
+// WithSeed sets the seed XOR'd into the hash table.
+func WithSeed(seed uint32) chunkers.Option {
+	return chunkers.OptionFunc(func(opts *chunkers.ChunkerOpts) { opts.Seed = seed })
+}
+
+var keyedTables sync.Map
+
+// keyedTableBase returns a base table derived from key, replacing tableBase
+// for keyed chunkers.
+func keyedTableBase(key []byte) *[256]uint32 {
+	if table, exists := keyedTables.Load(string(key)); exists {
+		return table.(*[256]uint32)
+	}
+
+	table := &[256]uint32{}
+	for i, value := range keyed.Uint64s(key, "buzhash.table", 256) {
+		table[i] = uint32(value)
+	}
+
+	actual, _ := keyedTables.LoadOrStore(string(key), table)
+	return actual.(*[256]uint32)
+}
+
+// Buzhash implements the cyclic polynomial rolling hash chunker of borg,
+// its parameters map onto borg's chunker_params: MinSize is 2^chunk_min_exp,
+// MaxSize is 2^chunk_max_exp, NormalSize is 2^hash_mask_bits and WindowSize
+// is hash_window_size. Its base table is not borg's yet, so it reports no
+// boundary version: its boundaries will change once borg's table lands.
+type Buzhash struct {
+	key   string
+	seed  uint32
+	ready bool
+	table [256]uint32
+}
+
+func newBuzhash() chunkers.ChunkerImplementation {
+	return &Buzhash{}
+}
+
+// hashTable returns the base table XOR'd with the seed in options, it is only
+// computed again when the key or the seed changes.
+func (c *Buzhash) hashTable(options *chunkers.ChunkerOpts) *[256]uint32 {
+	if !c.ready || string(options.Key) != c.key || options.Seed != c.seed {
+		base := &tableBase
+		if len(options.Key) != 0 {
+			base = keyedTableBase(options.Key)
+		}
+		for i := range c.table {
+			c.table[i] = base[i] ^ options.Seed
+		}
+		c.key, c.seed, c.ready = string(options.Key), options.Seed, true
+	}
+	return &c.table
+}
+
+func windowSize(options *chunkers.ChunkerOpts) int {
+	if options.WindowSize == 0 {
+		return defaultWindowSize
+	}
+	return options.WindowSize
+}
+
+func (c *Buzhash) DefaultOptions() *chunkers.ChunkerOpts {
+	return &chunkers.ChunkerOpts{
+		MinSize:    512 * 1024,
+		MaxSize:    8 * 1024 * 1024,
+		NormalSize: 2 * 1024 * 1024,
+		WindowSize: defaultWindowSize,
+	}
+}
+
+// Resolve sets the default window size for a zero WindowSize.
+func (c *Buzhash) Resolve(options *chunkers.ChunkerOpts) {
+	options.WindowSize = windowSize(options)
+}
+
+func (c *Buzhash) Keyed() bool {
+	return true
+}
+
+func (c *Buzhash) Constraints() []chunkers.Constraint {
+	return []chunkers.Constraint{
+		{Field: "NormalSize", Rule: "power of two, 64 <= NormalSize <= 1073741824"},
+		{Field: "WindowSize", Rule: "zero for 4095, or 1 <= WindowSize <= 65536"},
+		{Field: "MinSize", Rule: "64 <= MinSize < 1073741824"},
+		{Field: "MaxSize", Rule: "MinSize + WindowSize < MaxSize <= 1073741824"},
+		{Field: "Key", Rule: "empty or 32 bytes"},
+	}
+}
+
+func (c *Buzhash) Validate(options *chunkers.ChunkerOpts) error {
+	if options.NormalSize < 64 || options.NormalSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "NormalSize", Value: options.NormalSize, Min: 64, Max: 1024 * 1024 * 1024}
+	}
+	if bits.OnesCount(uint(options.NormalSize)) != 1 {
+		return &chunkers.OptionsError{Field: "NormalSize", Value: options.NormalSize, Min: 64, Max: 1024 * 1024 * 1024,
+			Reason: fmt.Sprintf("%d, must be a power of two", options.NormalSize)}
+	}
+	if options.WindowSize < 0 || options.WindowSize > 64*1024 {
+		return &chunkers.OptionsError{Field: "WindowSize", Value: options.WindowSize, Min: 0, Max: 64 * 1024}
+	}
+	if options.MinSize < 64 || options.MinSize >= 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "MinSize", Value: options.MinSize, Min: 64, Max: 1024*1024*1024 - 1}
+	}
+	if minMaxSize := options.MinSize + windowSize(options) + 1; options.MaxSize < minMaxSize || options.MaxSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "MaxSize", Value: options.MaxSize, Min: minMaxSize, Max: 1024 * 1024 * 1024}
+	}
+	if len(options.Key) != 0 && len(options.Key) != chunkers.KeySize {
+		return &chunkers.OptionsError{Field: "Key", Value: len(options.Key), Min: chunkers.KeySize, Max: chunkers.KeySize}
+	}
+	return nil
+}
+
+func (c *Buzhash) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) int {
+	MinSize := options.MinSize
+	MaxSize := options.MaxSize
+	WindowSize := windowSize(options)
+	mask := uint32(options.NormalSize - 1)
+
+	if n > MaxSize {
+		n = MaxSize
+	}
+	if n <= MinSize+WindowSize {
+		return n
+	}
+
+	h := c.hashTable(options)
+
+	// like borg, hashing starts with the window following MinSize and the
+	// chunk ends with the first window whose hash has all mask bits cleared.
+	sum := uint32(0)
+	for j, b := range data[MinSize : MinSize+WindowSize] {
+		sum ^= bits.RotateLeft32(h[b], (WindowSize-1-j)&0x1f)
+	}
+
+	rotation := WindowSize & 0x1f
+	i := MinSize
+	for ; sum&mask != 0 && i < n-WindowSize; i++ {
+		sum = bits.RotateLeft32(sum, 1) ^ bits.RotateLeft32(h[data[i]], rotation) ^ h[data[i+WindowSize]]
+	}
+	return i + WindowSize
+}
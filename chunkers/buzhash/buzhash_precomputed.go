@@ -0,0 +1,71 @@
+package buzhash
+
+// randomly generated base table, the table of a chunker is tableBase with
+// each entry XOR'd with its seed. Boundaries only match borg's once this file
+// is regenerated from borg with tests/testdata/borg/generate.py.
+var tableBase = [256]uint32{
+	0x8fc99f3f, 0xb47da48f, 0x0ebd4149, 0xb2635ff9,
+	0x91e4060f, 0xba95e7c8, 0xc9566605, 0x14a858b3,
+	0x4dd0dbc8, 0x5702afce, 0xc08ac9dd, 0x158cb939,
+	0x490c983d, 0x7c99f35a, 0xad1361fc, 0x5e4f2d5c,
+	0xf1479959, 0x58ed394c, 0x7c98e57d, 0x3c3ede24,
+	0x9ed0b61b, 0x87e7ef9c, 0xa93c894c, 0x4e8fc8ba,
+	0xe94bf0e6, 0x6d782a5d, 0x31a05faf, 0x52084450,
+	0xbcea266b, 0xeb4222e4, 0xb82d8e0b, 0x93b5e4f5,
+	0x97879be4, 0xa217c080, 0x931c6ae3, 0x5a2a5191,
+	0xeb8fac62, 0x9fa0c13f, 0xfa6f8e01, 0x8c82946e,
+	0x1d515a9d, 0xf42e7910, 0x29ec7cc2, 0xce3a663a,
+	0x25759e39, 0xfa57f384, 0xfef5fa84, 0xe50fb64e,
+	0x0b23ca6c, 0xc09894a5, 0xdd0ce6ce, 0x4fe75962,
+	0x6506f5d0, 0x330ea512, 0x54d9b8bf, 0xdd60bdff,
+	0x703bf755, 0x215a82d8, 0x1c0645c9, 0x4eed6172,
+	0xe0c89636, 0x273bab5c, 0x138abe6e, 0xdb3b644a,
+	0x2fb15bfe, 0xc7186bd8, 0x8f0ee9a5, 0xc347e777,
+	0x4924be29, 0x1babec09, 0x0cfc4756, 0x44f7143f,
+	0xce521940, 0xd9cb9e6b, 0xe6ef0d0f, 0xab9d6e6c,
+	0xe8116dbd, 0xd8cced97, 0x9afc8d1e, 0xf4482154,
+	0xf0485bb2, 0x6f9ab304, 0x189c6dd4, 0x6b1788b0,
+	0x2d4d4c11, 0xe18a4e12, 0x7b4420c8, 0x195ba787,
+	0xdced8064, 0x7dc29ffb, 0x2ea74347, 0x0486bbd7,
+	0x3c95a8b5, 0xb7a29b75, 0xbcf8788d, 0x5f63351e,
+	0x7bda8a9d, 0x9690041c, 0x7637976d, 0x08f13844,
+	0x94cdb767, 0xd885e82b, 0xd4334319, 0xf690781a,
+	0x9973e354, 0xc21762bd, 0xdf3569eb, 0xd9f2f589,
+	0x6045bbe8, 0xdca76d5a, 0xb130bc75, 0x254162e9,
+	0x0f4abde2, 0x3eeaeda6, 0x64df8e8f, 0xb577481a,
+	0xb1a7a46d, 0x63873809, 0x5906e610, 0xa8be668d,
+	0x073bbb10, 0x1ce5b353, 0x69a8d2cc, 0x5c9b38d9,
+	0x6177bce3, 0x30638262, 0x570e2bbf, 0xe1d1b2c1,
+	0x565fc603, 0x871afcae, 0x4d4d8c4d, 0xf91cbceb,
+	0x271cb6cb, 0x0668ed18, 0x028d2eff, 0x8627e02b,
+	0x2f73324d, 0x7629e857, 0x214111c9, 0x449fd2c4,
+	0x182bcfa0, 0x1a3a1044, 0xecaf2311, 0x8cd11e55,
+	0x5a861c97, 0xffe9af43, 0x9d6746b2, 0x4e337909,
+	0x23771594, 0x9e510e62, 0x9621bc16, 0xd1806edd,
+	0x2f7c297b, 0xe2ee3de9, 0x17286027, 0x350caaad,
+	0x090a9bb5, 0xaa14dccb, 0xaa94c454, 0xd8fee6ae,
+	0xfde5248b, 0xff4ec10a, 0x75546f4d, 0x1dfda5f9,
+	0x87436100, 0x63f13ee9, 0x84aebe52, 0x3695c28c,
+	0x744d5294, 0x983e8876, 0xc47c8014, 0x74689872,
+	0x9cba1d73, 0x27c37b98, 0xf7772682, 0xd91165a5,
+	0xd7a61a43, 0x3b436cf3, 0xd01c4991, 0x866e0eb3,
+	0x2146b056, 0xea4a16c9, 0xdfa12f7d, 0xf8b336e6,
+	0x4f9320aa, 0x68ec0985, 0x5e7c7fdf, 0x4d78b0d0,
+	0x56c47af4, 0xaf919582, 0xff880acf, 0xc1fb754e,
+	0x7fe74d0b, 0xf6353f13, 0x14f082da, 0x624f6cbc,
+	0xd934c2cd, 0xebcf2ff8, 0xfa5ef2ed, 0x08084d46,
+	0x9fb98a19, 0xb9db38c3, 0x4f5c34f4, 0x9f89ae1e,
+	0x81549a15, 0xfcf5ddd5, 0xfaadf508, 0xeeb584ed,
+	0x5134359b, 0x4536b6b0, 0xcfb7ca9f, 0x58767528,
+	0x476a0d0b, 0x0caaa508, 0x456e4ef6, 0x016248a0,
+	0x86b3af60, 0x248fe49d, 0xa496aef0, 0x5acd3d03,
+	0xed3b81a6, 0x7eee2e0f, 0x2f4cb6fd, 0xf45a2623,
+	0x333c482e, 0x1f630552, 0x42bee4b4, 0x06b42adc,
+	0xf0d92366, 0x4cfa1395, 0xc5af17ec, 0x500669bd,
+	0xafed3676, 0xeaf57b86, 0x968d7efc, 0xb3decf18,
+	0xb00fbe27, 0xd9374107, 0xf4d8cd46, 0x858364ce,
+	0x5ca13600, 0x642bdadd, 0x965a13c1, 0x76dfbcc6,
+	0x7c558a09, 0x6fcab293, 0xb7aac82e, 0xfa7d67bc,
+	0x9eb57993, 0x4e7649d3, 0xda266484, 0x16ad7950,
+	0x96a31b30, 0x72ca2b41, 0x8093dbd7, 0x30072445,
+}
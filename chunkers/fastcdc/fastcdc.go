@@ -17,44 +17,143 @@
 package fastcdc
 
 import (
-	"errors"
-	"unsafe"
+	"math"
 
 	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/internal/keyed"
 )
 
 func init() {
-	chunkers.Register("fastcdc", newFastCDC)
+	chunkers.MustRegister("fastcdc", newFastCDC)
 }
 
-var errNormalSize = errors.New("NormalSize is required and must be 64B <= NormalSize <= 1GB")
-var errMinSize = errors.New("MinSize is required and must be 64B <= MinSize <= 1GB && MinSize < NormalSize")
-var errMaxSize = errors.New("MaxSize is required and must be 64B <= MaxSize <= 1GB && MaxSize > NormalSize")
+const (
+	// masks of the paper for an 8KB NormalSize at normalization level 2
+	paperMaskS = uint64(0x0003590703530000)
+	paperMaskL = uint64(0x0000d90003530000)
+)
+
+// mask returns a mask of n bits spread over bits 16 to 62 of the fingerprint,
+// which depend on the most bytes. The masks of the paper are kept for 11 and
+// 15 bits so that boundaries with the default options are unchanged.
+func mask(n int) uint64 {
+	switch n {
+	case 11:
+		return paperMaskL
+	case 15:
+		return paperMaskS
+	}
+
+	m := uint64(0)
+	for i := 0; i < n; i++ {
+		m |= 1 << (16 + i*47/n)
+	}
+	return m
+}
+
+// defaultLevel is the normalization level selected by a zero
+// NormalizationLevel.
+const defaultLevel = 2
+
+// level returns the normalization level of options.
+func level(options *chunkers.ChunkerOpts) int {
+	switch options.NormalizationLevel {
+	case 0:
+		return defaultLevel
+	case chunkers.NormalizationNone:
+		return 0
+	}
+	return options.NormalizationLevel
+}
+
+// masks returns the masks used before and after NormalSize, which have
+// log2(NormalSize) bits plus and minus the normalization level.
+func masks(options *chunkers.ChunkerOpts) (uint64, uint64) {
+	n := int(math.Round(math.Log2(float64(options.NormalSize))))
+	l := level(options)
+	return mask(n + l), mask(n - l)
+}
+
+// WithNormalization sets the normalization level, from 0 to 3.
+func WithNormalization(level int) chunkers.Option {
+	if level == 0 {
+		level = chunkers.NormalizationNone
+	}
+	return chunkers.OptionFunc(func(opts *chunkers.ChunkerOpts) { opts.NormalizationLevel = level })
+}
 
 type FastCDC struct {
+	key  string
+	gear *[256]uint64
 }
 
 func newFastCDC() chunkers.ChunkerImplementation {
-	return &FastCDC{}
+	return &FastCDC{gear: &G}
+}
+
+// table returns the Gear table for the key in options, the keyed table is
+// only looked up again when the key changes.
+func (c *FastCDC) table(options *chunkers.ChunkerOpts) *[256]uint64 {
+	if string(options.Key) != c.key {
+		c.key = string(options.Key)
+		if c.key == "" {
+			c.gear = &G
+		} else {
+			c.gear = keyed.Gear(options.Key)
+		}
+	}
+	return c.gear
 }
 
 func (c *FastCDC) DefaultOptions() *chunkers.ChunkerOpts {
 	return &chunkers.ChunkerOpts{
-		MinSize:    2 * 1024,
-		MaxSize:    64 * 1024,
-		NormalSize: 8 * 1024,
+		MinSize:            2 * 1024,
+		MaxSize:            64 * 1024,
+		NormalSize:         8 * 1024,
+		NormalizationLevel: defaultLevel,
+	}
+}
+
+func (c *FastCDC) Version() string {
+	return "v2"
+}
+
+func (c *FastCDC) Keyed() bool {
+	return true
+}
+
+func (c *FastCDC) Constraints() []chunkers.Constraint {
+	return []chunkers.Constraint{
+		{Field: "NormalSize", Rule: "64 <= NormalSize <= 1073741824"},
+		{Field: "MinSize", Rule: "64 <= MinSize < NormalSize"},
+		{Field: "MaxSize", Rule: "NormalSize < MaxSize <= 1073741824"},
+		{Field: "NormalizationLevel", Rule: "NormalizationNone or 0 <= NormalizationLevel <= 3, 0 selects 2"},
+		{Field: "Key", Rule: "empty or 32 bytes"},
+	}
+}
+
+// Resolve sets the default level for a zero NormalizationLevel.
+func (c *FastCDC) Resolve(options *chunkers.ChunkerOpts) {
+	if options.NormalizationLevel == 0 {
+		options.NormalizationLevel = defaultLevel
 	}
 }
 
 func (c *FastCDC) Validate(options *chunkers.ChunkerOpts) error {
-	if options.NormalSize == 0 || options.NormalSize < 64 || options.NormalSize > 1024*1024*1024 {
-		return errNormalSize
+	if options.NormalSize < 64 || options.NormalSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "NormalSize", Value: options.NormalSize, Min: 64, Max: 1024 * 1024 * 1024}
 	}
-	if options.MinSize < 64 || options.MinSize > 1024*1024*1024 || options.MinSize >= options.NormalSize {
-		return errMinSize
+	if options.MinSize < 64 || options.MinSize >= options.NormalSize {
+		return &chunkers.OptionsError{Field: "MinSize", Value: options.MinSize, Min: 64, Max: options.NormalSize - 1}
 	}
-	if options.MaxSize < 64 || options.MaxSize > 1024*1024*1024 || options.MaxSize <= options.NormalSize {
-		return errMaxSize
+	if options.MaxSize <= options.NormalSize || options.MaxSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "MaxSize", Value: options.MaxSize, Min: options.NormalSize + 1, Max: 1024 * 1024 * 1024}
+	}
+	if options.NormalizationLevel < chunkers.NormalizationNone || options.NormalizationLevel > 3 {
+		return &chunkers.OptionsError{Field: "NormalizationLevel", Value: options.NormalizationLevel, Min: chunkers.NormalizationNone, Max: 3}
+	}
+	if len(options.Key) != 0 && len(options.Key) != chunkers.KeySize {
+		return &chunkers.OptionsError{Field: "Key", Value: len(options.Key), Min: chunkers.KeySize, Max: chunkers.KeySize}
 	}
 	return nil
 }
@@ -64,10 +163,7 @@ func (c *FastCDC) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) i
 	MaxSize := options.MaxSize
 	NormalSize := options.NormalSize
 
-	const (
-		MaskS = uint64(0x0003590703530000)
-		MaskL = uint64(0x0000d90003530000)
-	)
+	MaskS, MaskL := masks(options)
 
 	switch {
 	case n <= MinSize:
@@ -78,20 +174,22 @@ func (c *FastCDC) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) i
 		NormalSize = n
 	}
 
+	G := c.table(options)
+
+	data = data[:n]
+
 	fp := uint64(0)
 	i := MinSize
 	mask := MaskS
 
-	p := unsafe.Pointer(&data[i])
 	for ; i < n; i++ {
 		if i == NormalSize {
 			mask = MaskL
 		}
-		fp = (fp << 1) + G[*(*byte)(p)]
+		fp = (fp << 1) + G[data[i]]
 		if (fp & mask) == 0 {
 			return i
 		}
-		p = unsafe.Pointer(uintptr(p) + 1)
 	}
 	return i
 }
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fastcdc
+
+import (
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func init() {
+	chunkers.MustRegister("fastcdc-v2020", newFastCDC2020)
+}
+
+// FastCDC2020 implements the "rolling two bytes each time" optimization of
+// the 2020 FastCDC paper: the first byte of each pair goes through a Gear
+// table shifted left by one bit and is tested against a shifted mask, which
+// saves a shift per byte while producing the same boundaries as FastCDC.
+type FastCDC2020 struct {
+	FastCDC
+	shiftedFrom *[256]uint64
+	shifted     [256]uint64
+}
+
+func newFastCDC2020() chunkers.ChunkerImplementation {
+	return &FastCDC2020{FastCDC: FastCDC{gear: &G}}
+}
+
+// tables returns the Gear table for the key in options along with its left
+// shifted copy, which is only computed again when the key changes.
+func (c *FastCDC2020) tables(options *chunkers.ChunkerOpts) (*[256]uint64, *[256]uint64) {
+	gear := c.table(options)
+	if gear != c.shiftedFrom {
+		for i, value := range gear {
+			c.shifted[i] = value << 1
+		}
+		c.shiftedFrom = gear
+	}
+	return gear, &c.shifted
+}
+
+func (c *FastCDC2020) Version() string {
+	return "v2"
+}
+
+func (c *FastCDC2020) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) int {
+	MinSize := options.MinSize
+	MaxSize := options.MaxSize
+	NormalSize := options.NormalSize
+
+	MaskS, MaskL := masks(options)
+	MaskSLS, MaskLLS := MaskS<<1, MaskL<<1
+
+	switch {
+	case n <= MinSize:
+		return n
+	case n >= MaxSize:
+		n = MaxSize
+	case n <= NormalSize:
+		NormalSize = n
+	}
+
+	G, GLS := c.tables(options)
+	data = data[:n]
+
+	fp := uint64(0)
+	i := MinSize
+	for ; i+1 < NormalSize; i += 2 {
+		pair := data[i : i+2]
+		fp = (fp << 2) + GLS[pair[0]]
+		if (fp & MaskSLS) == 0 {
+			return i
+		}
+		fp += G[pair[1]]
+		if (fp & MaskS) == 0 {
+			return i + 1
+		}
+	}
+	if i < NormalSize {
+		fp = (fp << 1) + G[data[i]]
+		if (fp & MaskS) == 0 {
+			return i
+		}
+		i++
+	}
+
+	for ; i+1 < n; i += 2 {
+		pair := data[i : i+2]
+		fp = (fp << 2) + GLS[pair[0]]
+		if (fp & MaskLLS) == 0 {
+			return i
+		}
+		fp += G[pair[1]]
+		if (fp & MaskL) == 0 {
+			return i + 1
+		}
+	}
+	if i < n {
+		fp = (fp << 1) + G[data[i]]
+		if (fp & MaskL) == 0 {
+			return i
+		}
+		i++
+	}
+	return i
+}
@@ -17,28 +17,68 @@
 package jc
 
 import (
-	"errors"
 	"math"
-	"unsafe"
 
 	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/internal/keyed"
 )
 
 func init() {
-	chunkers.Register("jc", newJC)
+	chunkers.MustRegister("jc", newJC)
 }
 
-var errNormalSize = errors.New("NormalSize is required and must be 64B <= NormalSize <= 1GB")
-var errMinSize = errors.New("MinSize is required and must be 64B <= MinSize <= 1GB && MinSize < NormalSize")
-var errMaxSize = errors.New("MaxSize is required and must be 64B <= MaxSize <= 1GB && MaxSize > NormalSize")
-
 type JC struct {
-	computeJumpLength bool
-	jumpLength        int
+	normalSize int
+	maskC      uint64
+	maskJ      uint64
+	jumpLength int
+
+	key  string
+	gear *[256]uint64
 }
 
 func newJC() chunkers.ChunkerImplementation {
-	return &JC{}
+	return &JC{gear: &G}
+}
+
+// table returns the Gear table for the key in options, the keyed table is
+// only looked up again when the key changes.
+func (c *JC) table(options *chunkers.ChunkerOpts) *[256]uint64 {
+	if string(options.Key) != c.key {
+		c.key = string(options.Key)
+		if c.key == "" {
+			c.gear = &G
+		} else {
+			c.gear = keyed.Gear(options.Key)
+		}
+	}
+	return c.gear
+}
+
+// mask returns a mask of n bits spread over bits 16 to 62 of the fingerprint,
+// which depend on the most bytes.
+func mask(n int) uint64 {
+	m := uint64(0)
+	for i := 0; i < n; i++ {
+		m |= 1 << (62 - i*47/n)
+	}
+	return m
+}
+
+// parameters returns the cut and jump masks and the jump length for the
+// NormalSize in options, they are only computed again when it changes.
+func (c *JC) parameters(options *chunkers.ChunkerOpts) (uint64, uint64, int) {
+	if options.NormalSize != c.normalSize {
+		c.normalSize = options.NormalSize
+		cOnes := int(math.Log2(float64(options.NormalSize))) - 1
+		jOnes := cOnes - 1
+		c.maskC = mask(cOnes)
+		// MaskJ is MaskC without its lowest bit, so that a cut point is
+		// always a jump point.
+		c.maskJ = c.maskC & (c.maskC - 1)
+		c.jumpLength = ((1 << jOnes) * cOnes) / ((1 << cOnes) - (1 << jOnes))
+	}
+	return c.maskC, c.maskJ, c.jumpLength
 }
 
 func (c *JC) DefaultOptions() *chunkers.ChunkerOpts {
@@ -49,15 +89,35 @@ func (c *JC) DefaultOptions() *chunkers.ChunkerOpts {
 	}
 }
 
+func (c *JC) Version() string {
+	return "v2"
+}
+
+func (c *JC) Keyed() bool {
+	return true
+}
+
+func (c *JC) Constraints() []chunkers.Constraint {
+	return []chunkers.Constraint{
+		{Field: "NormalSize", Rule: "64 <= NormalSize <= 1073741824"},
+		{Field: "MinSize", Rule: "64 <= MinSize < NormalSize"},
+		{Field: "MaxSize", Rule: "NormalSize < MaxSize <= 1073741824"},
+		{Field: "Key", Rule: "empty or 32 bytes"},
+	}
+}
+
 func (c *JC) Validate(options *chunkers.ChunkerOpts) error {
-	if options.NormalSize == 0 || options.NormalSize < 64 || options.NormalSize > 1024*1024*1024 {
-		return errNormalSize
+	if options.NormalSize < 64 || options.NormalSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "NormalSize", Value: options.NormalSize, Min: 64, Max: 1024 * 1024 * 1024}
 	}
-	if options.MinSize < 64 || options.MinSize > 1024*1024*1024 || options.MinSize >= options.NormalSize {
-		return errMinSize
+	if options.MinSize < 64 || options.MinSize >= options.NormalSize {
+		return &chunkers.OptionsError{Field: "MinSize", Value: options.MinSize, Min: 64, Max: options.NormalSize - 1}
 	}
-	if options.MaxSize < 64 || options.MaxSize > 1024*1024*1024 || options.MaxSize <= options.NormalSize {
-		return errMaxSize
+	if options.MaxSize <= options.NormalSize || options.MaxSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "MaxSize", Value: options.MaxSize, Min: options.NormalSize + 1, Max: 1024 * 1024 * 1024}
+	}
+	if len(options.Key) != 0 && len(options.Key) != chunkers.KeySize {
+		return &chunkers.OptionsError{Field: "Key", Value: len(options.Key), Min: chunkers.KeySize, Max: chunkers.KeySize}
 	}
 	return nil
 }
@@ -65,46 +125,27 @@ func (c *JC) Validate(options *chunkers.ChunkerOpts) error {
 func (c *JC) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) int {
 	MinSize := options.MinSize
 	MaxSize := options.MaxSize
-	NormalSize := options.NormalSize
-
-	const (
-		MaskC = uint64(0x590003570000)
-		MaskJ = uint64(0x590003560000)
-	)
 
 	switch {
 	case n <= MinSize:
 		return n
 	case n >= MaxSize:
 		n = MaxSize
-	case n <= NormalSize:
-		NormalSize = n
 	}
 
-	fp := uint64(0)
-	i := MinSize
+	G := c.table(options)
+	MaskC, MaskJ, jumpLength := c.parameters(options)
 
-	if c.computeJumpLength {
-		cOnes := int(math.Log2(float64(NormalSize))) - 1
-		jOnes := cOnes - 1
-		c.jumpLength = ((1 << jOnes) * cOnes) / ((1 << cOnes) - (1 << jOnes))
-	}
-
-	var p unsafe.Pointer
-	for ; i < n; i++ {
-		p = unsafe.Pointer(&data[i])
-		fp = (fp << 1) + G[*(*byte)(p)]
+	fp := uint64(0)
+	for i := MinSize; i < n; i++ {
+		fp = (fp << 1) + G[data[i]]
 		if (fp & MaskJ) == 0 {
 			if (fp & MaskC) == 0 {
 				return i
 			}
 			fp = 0
-			i = i + c.jumpLength
+			i = i + jumpLength
 		}
-		i++
-	}
-	if i > n {
-		i = n
 	}
-	return i
+	return n
 }
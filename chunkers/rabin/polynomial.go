@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rabin
+
+import "math/bits"
+
+// Polynomials over F_2 are represented as uint64, bit i being the
+// coefficient of x^i.
+
+func deg(x uint64) int {
+	return bits.Len64(x) - 1
+}
+
+func mod(x, d uint64) uint64 {
+	for D := deg(d); deg(x) >= D; {
+		x ^= d << uint(deg(x)-D)
+	}
+	return x
+}
+
+// mulMod returns x*f mod g, x and f must already be reduced modulo g.
+func mulMod(x, f, g uint64) uint64 {
+	top := uint64(1) << uint(deg(g))
+	res := uint64(0)
+	for ; f != 0; f >>= 1 {
+		if f&1 != 0 {
+			res ^= x
+		}
+		x <<= 1
+		if x&top != 0 {
+			x ^= g
+		}
+	}
+	return res
+}
+
+func gcd(x, f uint64) uint64 {
+	for f != 0 {
+		x, f = f, mod(x, f)
+	}
+	return x
+}
+
+// irreducible reports whether x is irreducible over F_2 using Ben-Or's test:
+// x is irreducible if gcd(x, x^(2^i) - x) = 1 for all i <= deg(x)/2.
+func irreducible(x uint64) bool {
+	if x < 2 {
+		return false
+	}
+	r := mod(2, x)
+	for i := 1; i <= deg(x)/2; i++ {
+		r = mulMod(r, r, x)
+		if gcd(x, r^mod(2, x)) != 1 {
+			return false
+		}
+	}
+	return true
+}
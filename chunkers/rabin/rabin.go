@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rabin
+
+import (
+	"fmt"
+	"math/bits"
+	"sync"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/internal/keyed"
+)
+
+func init() {
+	chunkers.MustRegister("rabin", newRabin)
+}
+
+const (
+	// windowSize is the number of bytes covered by the fingerprint.
+	windowSize = 64
+
+	// maxDegree keeps the top byte of the fingerprint within 64 bits.
+	maxDegree = 53
+
+	// defaultPolynomial is used when neither a Polynomial nor a Key is set.
+	defaultPolynomial uint64 = 0x3DA3358B4DC173
+)
+
+// WithPolynomial sets the irreducible polynomial, it can't be combined with a
+// key.
+func WithPolynomial(pol uint64) chunkers.Option {
+	return chunkers.OptionFunc(func(opts *chunkers.ChunkerOpts) { opts.Polynomial = pol })
+}
+
+// tables holds the precomputed values used to slide a byte out of the window
+// and to reduce the fingerprint modulo a polynomial, as in restic/chunker.
+type tables struct {
+	shift uint
+	out   [256]uint64
+	mod   [256]uint64
+}
+
+var tablesCache sync.Map
+
+func newTables(pol uint64) *tables {
+	if t, exists := tablesCache.Load(pol); exists {
+		return t.(*tables)
+	}
+
+	k := deg(pol)
+	t := &tables{shift: uint(k - 8)}
+	for b := 0; b < 256; b++ {
+		// out[b] is the fingerprint of b followed by windowSize-1 zero bytes,
+		// adding it to a fingerprint cancels b out of the window.
+		h := mod(uint64(b), pol)
+		for i := 0; i < windowSize-1; i++ {
+			h = mod(h<<8, pol)
+		}
+		t.out[b] = h
+
+		// mod[b] reduces the byte above the degree of pol and cancels it.
+		t.mod[b] = mod(uint64(b)<<uint(k), pol) | uint64(b)<<uint(k)
+	}
+
+	actual, _ := tablesCache.LoadOrStore(pol, t)
+	return actual.(*tables)
+}
+
+var keyedPolynomials sync.Map
+
+// keyedPolynomial derives an irreducible polynomial of degree 53 from key,
+// the same way restic/chunker derives one from a random source.
+func keyedPolynomial(key []byte) uint64 {
+	if pol, exists := keyedPolynomials.Load(string(key)); exists {
+		return pol.(uint64)
+	}
+
+	// about one in 27 candidates is irreducible, failing to find one among
+	// 1024 is not going to happen.
+	for _, candidate := range keyed.Uint64s(key, "rabin.polynomial", 1024) {
+		pol := candidate&(1<<(maxDegree+1)-1) | 1<<maxDegree | 1
+		if irreducible(pol) {
+			actual, _ := keyedPolynomials.LoadOrStore(string(key), pol)
+			return actual.(uint64)
+		}
+	}
+	panic("rabin: no irreducible polynomial derived from key")
+}
+
+var irreduciblePolynomials sync.Map
+
+func checkIrreducible(pol uint64) bool {
+	if ok, exists := irreduciblePolynomials.Load(pol); exists {
+		return ok.(bool)
+	}
+	ok := irreducible(pol)
+	irreduciblePolynomials.Store(pol, ok)
+	return ok
+}
+
+type Rabin struct {
+	key       string
+	keyedPol  uint64
+	pol       uint64
+	polTables *tables
+}
+
+func newRabin() chunkers.ChunkerImplementation {
+	return &Rabin{}
+}
+
+// tables returns the tables for the polynomial selected by options, they are
+// only looked up again when the polynomial changes.
+func (c *Rabin) tables(options *chunkers.ChunkerOpts) *tables {
+	pol := options.Polynomial
+	if pol == 0 {
+		if len(options.Key) == 0 {
+			pol = defaultPolynomial
+		} else {
+			if string(options.Key) != c.key {
+				c.key = string(options.Key)
+				c.keyedPol = keyedPolynomial(options.Key)
+			}
+			pol = c.keyedPol
+		}
+	}
+	if c.polTables == nil || pol != c.pol {
+		c.pol = pol
+		c.polTables = newTables(pol)
+	}
+	return c.polTables
+}
+
+func (c *Rabin) DefaultOptions() *chunkers.ChunkerOpts {
+	return &chunkers.ChunkerOpts{
+		MinSize:    512 * 1024,
+		MaxSize:    8 * 1024 * 1024,
+		NormalSize: 1024 * 1024,
+	}
+}
+
+func (c *Rabin) Version() string {
+	return "v1"
+}
+
+func (c *Rabin) Keyed() bool {
+	return true
+}
+
+func (c *Rabin) Constraints() []chunkers.Constraint {
+	return []chunkers.Constraint{
+		{Field: "NormalSize", Rule: "power of two, 64 <= NormalSize <= 1073741824"},
+		{Field: "MinSize", Rule: "64 <= MinSize < 1073741824"},
+		{Field: "MaxSize", Rule: "MinSize < MaxSize <= 1073741824"},
+		{Field: "Key", Rule: "empty or 32 bytes"},
+		{Field: "Polynomial", Rule: "zero for the default, or irreducible of degree log2(NormalSize)+1 to 53 without a Key"},
+	}
+}
+
+func (c *Rabin) Validate(options *chunkers.ChunkerOpts) error {
+	if options.NormalSize < 64 || options.NormalSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "NormalSize", Value: options.NormalSize, Min: 64, Max: 1024 * 1024 * 1024}
+	}
+	if bits.OnesCount(uint(options.NormalSize)) != 1 {
+		return &chunkers.OptionsError{Field: "NormalSize", Value: options.NormalSize, Min: 64, Max: 1024 * 1024 * 1024,
+			Reason: fmt.Sprintf("%d, must be a power of two", options.NormalSize)}
+	}
+	if options.MinSize < windowSize || options.MinSize >= 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "MinSize", Value: options.MinSize, Min: windowSize, Max: 1024*1024*1024 - 1}
+	}
+	if options.MaxSize <= options.MinSize || options.MaxSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "MaxSize", Value: options.MaxSize, Min: options.MinSize + 1, Max: 1024 * 1024 * 1024}
+	}
+	if len(options.Key) != 0 && len(options.Key) != chunkers.KeySize {
+		return &chunkers.OptionsError{Field: "Key", Value: len(options.Key), Min: chunkers.KeySize, Max: chunkers.KeySize}
+	}
+	if options.Polynomial != 0 {
+		if len(options.Key) != 0 {
+			return &chunkers.OptionsError{Field: "Polynomial", Value: int(options.Polynomial),
+				Reason: fmt.Sprintf("%#x, must not be set with a Key", options.Polynomial)}
+		}
+		// the fingerprint must have more bits than the mask it is tested against
+		minDegree := bits.TrailingZeros(uint(options.NormalSize)) + 1
+		if d := deg(options.Polynomial); d < minDegree || d > maxDegree {
+			return &chunkers.OptionsError{Field: "Polynomial", Value: int(options.Polynomial),
+				Reason: fmt.Sprintf("%#x, must be of degree %d <= degree <= %d", options.Polynomial, minDegree, maxDegree)}
+		}
+		if !checkIrreducible(options.Polynomial) {
+			return &chunkers.OptionsError{Field: "Polynomial", Value: int(options.Polynomial),
+				Reason: fmt.Sprintf("%#x, must be irreducible", options.Polynomial)}
+		}
+	}
+	return nil
+}
+
+func (c *Rabin) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) int {
+	MinSize := options.MinSize
+	MaxSize := options.MaxSize
+	mask := uint64(options.NormalSize - 1)
+
+	switch {
+	case n <= MinSize:
+		return n
+	case n > MaxSize:
+		n = MaxSize
+	}
+
+	t := c.tables(options)
+	shift := t.shift & 63
+
+	// Like restic, the fingerprint of a chunk starts from a window holding a
+	// single 1 byte and only covers the windowSize bytes leading to MinSize.
+	digest := uint64(1)
+	i := MinSize - windowSize
+	for ; i < MinSize-1; i++ {
+		digest = (digest<<8 | uint64(data[i])) ^ t.mod[digest>>shift]
+	}
+
+	digest ^= t.out[1]
+	digest = (digest<<8 | uint64(data[i])) ^ t.mod[digest>>shift]
+	if digest&mask == 0 {
+		return MinSize
+	}
+
+	for i = MinSize; i < n; i++ {
+		digest ^= t.out[data[i-windowSize]]
+		digest = (digest<<8 | uint64(data[i])) ^ t.mod[digest>>shift]
+		if digest&mask == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
@@ -17,60 +17,201 @@
 package ultracdc
 
 import (
-	"errors"
+	"encoding/binary"
+	"math"
 	"math/bits"
-	"unsafe"
+	"sync"
 
 	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/internal/keyed"
 )
 
 func init() {
-	chunkers.Register("ultracdc", newUltraCDC)
+	chunkers.MustRegister("ultracdc", newUltraCDC)
 }
 
-var errMinSize = errors.New("MinSize is required and must be 64B <= MinSize <= 1GB")
-var errMaxSize = errors.New("MaxSize is required and must be 64B <= MaxSize <= 1GB")
+const defaultPattern byte = 0xAA
+
+// weights holds the Hamming distance of each byte value to the pattern, the
+// distance of a window to the pattern is the sum of the weights of its bytes
+// and sliding the window by a byte adds weights[in] - weights[out].
+type weights [256]int
+
+func newWeights(perm *[256]byte, pattern byte) *weights {
+	w := &weights{}
+	for b := range w {
+		w[b] = bits.OnesCount8(perm[b] ^ pattern)
+	}
+	return w
+}
+
+var defaultWeights = func() *weights {
+	var identity [256]byte
+	for b := range identity {
+		identity[b] = byte(b)
+	}
+	return newWeights(&identity, defaultPattern)
+}()
+
+var keyedWeightsCache sync.Map
+
+// keyedWeights returns the weights derived from key: the pattern is drawn
+// from the key and compared to a keyed permutation of the byte values.
+func keyedWeights(key []byte) *weights {
+	if w, exists := keyedWeightsCache.Load(string(key)); exists {
+		return w.(*weights)
+	}
+
+	pattern := byte(keyed.Uint64s(key, "ultracdc.pattern", 1)[0])
+	w := newWeights(keyed.Permutation(key, "ultracdc.table"), pattern)
+
+	actual, _ := keyedWeightsCache.LoadOrStore(string(key), w)
+	return actual.(*weights)
+}
+
+// cutProbabilities holds, for each mask, the probability that the distance of
+// a window of random bytes, which follows the binomial distribution B(64, 1/2),
+// has none of the bits of the mask set.
+var cutProbabilities = func() (p [128]float64) {
+	binomial := 1.0
+	for d := 0; d <= 64; d++ {
+		if d > 0 {
+			binomial = binomial * float64(64-d+1) / float64(d)
+		}
+		for mask := range p {
+			if d&mask == 0 {
+				p[mask] += binomial / (1 << 64)
+			}
+		}
+	}
+	return p
+}()
+
+// scaledMask returns the mask whose cut probability is the closest to that of
+// mask8K scaled from an 8KB NormalSize to normalSize, the masks of the paper
+// are thus used unchanged for an 8KB NormalSize.
+func scaledMask(mask8K int, normalSize int) int {
+	target := math.Log(cutProbabilities[mask8K] * 8192 / float64(normalSize))
+
+	best := mask8K
+	for mask := 1; mask < len(cutProbabilities); mask++ {
+		if math.Abs(math.Log(cutProbabilities[mask])-target) < math.Abs(math.Log(cutProbabilities[best])-target) {
+			best = mask
+		}
+	}
+	return best
+}
 
 type UltraCDC struct {
+	key     string
+	weights *weights
+
+	normalSize int
+	maskS      int
+	maskL      int
 }
 
 func newUltraCDC() chunkers.ChunkerImplementation {
-	return &UltraCDC{}
+	return &UltraCDC{weights: defaultWeights}
+}
+
+// byteWeights returns the weights for the key in options, the keyed weights
+// are only looked up again when the key changes.
+func (c *UltraCDC) byteWeights(options *chunkers.ChunkerOpts) *weights {
+	if c.weights == nil || string(options.Key) != c.key {
+		c.key = string(options.Key)
+		if c.key == "" {
+			c.weights = defaultWeights
+		} else {
+			c.weights = keyedWeights(options.Key)
+		}
+	}
+	return c.weights
+}
+
+// normalSize returns the NormalSize of options, a zero NormalSize is derived
+// as four times MinSize, kept below MaxSize.
+func normalSize(options *chunkers.ChunkerOpts) int {
+	if options.NormalSize != 0 {
+		return options.NormalSize
+	}
+	return min(4*options.MinSize, options.MaxSize-1)
+}
+
+// masks returns the masks used before and after normalSize, they are only
+// computed again when it changes.
+func (c *UltraCDC) masks(normalSize int) (int, int) {
+	if normalSize != c.normalSize {
+		c.normalSize = normalSize
+		c.maskS = scaledMask(0x2F, normalSize)
+		c.maskL = scaledMask(0x2C, normalSize)
+	}
+	return c.maskS, c.maskL
 }
 
 func (c *UltraCDC) DefaultOptions() *chunkers.ChunkerOpts {
 	return &chunkers.ChunkerOpts{
-		MinSize: 2 * 1024,
-		MaxSize: 64 * 1024,
+		MinSize:    2 * 1024,
+		MaxSize:    64 * 1024,
+		NormalSize: 8 * 1024,
 	}
 }
 
+func (c *UltraCDC) Version() string {
+	return "v2"
+}
+
+func (c *UltraCDC) Keyed() bool {
+	return true
+}
+
+func (c *UltraCDC) Constraints() []chunkers.Constraint {
+	return []chunkers.Constraint{
+		{Field: "NormalSize", Rule: "64 <= NormalSize <= 1073741824, 0 selects min(4*MinSize, MaxSize-1)"},
+		{Field: "MinSize", Rule: "64 <= MinSize < NormalSize"},
+		{Field: "MaxSize", Rule: "NormalSize < MaxSize <= 1073741824"},
+		{Field: "Key", Rule: "empty or 32 bytes"},
+	}
+}
+
+// Resolve sets the NormalSize derived from a zero one.
+func (c *UltraCDC) Resolve(options *chunkers.ChunkerOpts) {
+	options.NormalSize = normalSize(options)
+}
+
 func (c *UltraCDC) Validate(options *chunkers.ChunkerOpts) error {
-	if options.MinSize < 64 || options.MinSize > 1024*1024*1024 {
-		return errMinSize
+	if options.NormalSize != 0 && (options.NormalSize < 64 || options.NormalSize > 1024*1024*1024) {
+		return &chunkers.OptionsError{Field: "NormalSize", Value: options.NormalSize, Min: 64, Max: 1024 * 1024 * 1024}
+	}
+	if options.NormalSize == 0 {
+		if options.MinSize < 64 || options.MinSize > 1024*1024*1024-2 {
+			return &chunkers.OptionsError{Field: "MinSize", Value: options.MinSize, Min: 64, Max: 1024*1024*1024 - 2}
+		}
+		if options.MaxSize < options.MinSize+2 || options.MaxSize > 1024*1024*1024 {
+			return &chunkers.OptionsError{Field: "MaxSize", Value: options.MaxSize, Min: options.MinSize + 2, Max: 1024 * 1024 * 1024}
+		}
 	}
-	if options.MaxSize < 64 || options.MaxSize > 1024*1024*1024 {
-		return errMaxSize
+	normalSize := normalSize(options)
+	if options.MinSize < 64 || options.MinSize >= normalSize {
+		return &chunkers.OptionsError{Field: "MinSize", Value: options.MinSize, Min: 64, Max: normalSize - 1}
+	}
+	if options.MaxSize <= normalSize || options.MaxSize > 1024*1024*1024 {
+		return &chunkers.OptionsError{Field: "MaxSize", Value: options.MaxSize, Min: normalSize + 1, Max: 1024 * 1024 * 1024}
+	}
+	if len(options.Key) != 0 && len(options.Key) != chunkers.KeySize {
+		return &chunkers.OptionsError{Field: "Key", Value: len(options.Key), Min: chunkers.KeySize, Max: chunkers.KeySize}
 	}
 	return nil
 }
 
 func (c *UltraCDC) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) int {
-	src := (*uint64)(unsafe.Pointer(&data[0]))
-
 	const (
-		Pattern uint64 = 0xAAAAAAAAAAAAAAAA
-		MaskS   uint64 = 0x2F
-		MaskL   uint64 = 0x2C
-		LEST    uint32 = 64
+		LEST uint32 = 64
 	)
 	MinSize := options.MinSize
 	MaxSize := options.MaxSize
-	NormalSize := options.NormalSize
-
-	i := MinSize
-	cnt := uint32(0)
-	mask := MaskS
+	NormalSize := normalSize(options)
+	MaskS, MaskL := c.masks(NormalSize)
 
 	switch {
 	case n <= MinSize:
@@ -80,38 +221,58 @@ func (c *UltraCDC) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int)
 	case n <= NormalSize:
 		NormalSize = n
 	}
+	if n < MinSize+8 {
+		return n
+	}
+
+	w := c.byteWeights(options)
+	data = data[:n]
+
+	i := MinSize
+	cnt := uint32(0)
+	mask := MaskS
 
-	outBufWin := (*uint64)(unsafe.Pointer(uintptr(unsafe.Pointer(src)) + uintptr(i)))
-	dist := uint64(bits.OnesCount64(*outBufWin ^ Pattern))
+	outBufWin := data[i : i+8]
+	dist := 0
+	for _, b := range outBufWin {
+		dist += w[b]
+	}
 	i += 8
 
-	for i < n {
-		if i == NormalSize {
+	for ; i+8 <= n; i += 8 {
+		if i >= NormalSize {
 			mask = MaskL
 		}
 
-		inBufWin := (*uint64)(unsafe.Pointer(uintptr(unsafe.Pointer(src)) + uintptr(i)))
-		if (*outBufWin ^ *inBufWin) == 0 {
+		// low entropy strings, such as runs of zeroes, are cut every LEST
+		// identical windows
+		inBufWin := data[i : i+8]
+		if binary.LittleEndian.Uint64(outBufWin) == binary.LittleEndian.Uint64(inBufWin) {
 			cnt++
 			if cnt == LEST {
 				return i + 8
 			}
-			i += 8
 			continue
 		}
 
 		cnt = 0
 		for j := 0; j < 8; j++ {
 			if (dist & mask) == 0 {
-				return i + 8
+				return i + j
 			}
-			inByte := *(*byte)(unsafe.Pointer(uintptr(unsafe.Pointer(inBufWin)) + uintptr(j)))
-			outByte := *(*byte)(unsafe.Pointer(uintptr(unsafe.Pointer(outBufWin)) + uintptr(j)))
-			dist = dist + uint64(hammingDistanceTable[outByte][inByte])
+			dist += w[inBufWin[j]] - w[outBufWin[j]]
 		}
 		outBufWin = inBufWin
-		i += 8
 	}
 
+	for ; i < n; i++ {
+		if i >= NormalSize {
+			mask = MaskL
+		}
+		if (dist & mask) == 0 {
+			return i
+		}
+		dist += w[data[i]] - w[data[i-8]]
+	}
 	return n
 }
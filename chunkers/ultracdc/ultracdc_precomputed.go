@@ -1,299 +0,0 @@
-package ultracdc
-
-// precomputed distance table using the following code:
-/*
-	package main
-
-	import (
-		"fmt"
-	)
-
-	// Helper function to compute the Hamming weight (number of set bits) of a byte.
-	func hammingWeight(b byte) int {
-		count := 0
-		for b != 0 {
-			count++
-			b &= b - 1 // Clear the least significant set bit
-		}
-		return count
-	}
-
-	// Function to compute the Hamming distance between two bytes.
-	func hammingDistance(a, b byte) int {
-		return hammingWeight(a ^ b)
-	}
-
-	func main() {
-		fmt.Println("var hammingDistanceTable [256][256]int = [256][256]int{")
-
-		for outByte := 0; outByte < 256; outByte++ {
-			fmt.Print("{")
-			for inByte := 0; inByte < 256; inByte++ {
-				distance := hammingDistance(byte(inByte), byte(outByte))
-				fmt.Printf("%d, ", distance)
-			}
-			fmt.Println("},")
-		}
-
-		fmt.Println("}")
-	}
-*/
-
-var hammingDistanceTable [256][256]int = [256][256]int{
-	{0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8},
-	{1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7},
-	{1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7},
-	{2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6},
-	{1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7},
-	{2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6},
-	{2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6},
-	{3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5},
-	{1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7},
-	{2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6},
-	{2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6},
-	{3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5},
-	{2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6},
-	{3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5},
-	{3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5},
-	{4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4},
-	{1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7},
-	{2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6},
-	{2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6},
-	{3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5},
-	{2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6},
-	{3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5},
-	{3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5},
-	{4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4},
-	{2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3},
-	{1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7},
-	{2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6},
-	{2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6},
-	{3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5},
-	{2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6},
-	{3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5},
-	{3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5},
-	{4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4},
-	{2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3},
-	{2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3},
-	{3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2},
-	{1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7},
-	{2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6},
-	{2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6},
-	{3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5},
-	{2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6},
-	{3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5},
-	{3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5},
-	{4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4},
-	{2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3},
-	{2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3},
-	{3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2},
-	{2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3},
-	{3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2},
-	{3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2},
-	{4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4},
-	{5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3},
-	{5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3},
-	{6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2},
-	{5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3},
-	{6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2},
-	{6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2},
-	{7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1},
-	{1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7},
-	{2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6},
-	{2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6},
-	{3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5},
-	{2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6},
-	{3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5},
-	{3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5},
-	{4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4},
-	{2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3},
-	{2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3},
-	{3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2},
-	{2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3},
-	{3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2},
-	{3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2},
-	{4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4},
-	{5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3},
-	{5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3},
-	{6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2},
-	{5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3},
-	{6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2},
-	{6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2},
-	{7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1},
-	{2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6},
-	{3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5},
-	{3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5},
-	{4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4},
-	{3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5},
-	{4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4},
-	{4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4},
-	{5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3},
-	{3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2},
-	{3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2},
-	{4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4},
-	{5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3},
-	{5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3},
-	{6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2},
-	{5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3},
-	{6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2},
-	{6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2},
-	{7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1},
-	{3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5},
-	{4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4},
-	{4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4},
-	{5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3},
-	{4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4},
-	{5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3},
-	{5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3},
-	{6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2},
-	{4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4},
-	{5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3},
-	{5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3},
-	{6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2},
-	{5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3},
-	{6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2},
-	{6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2},
-	{7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1},
-	{4, 5, 5, 6, 5, 6, 6, 7, 5, 6, 6, 7, 6, 7, 7, 8, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 0, 1, 1, 2, 1, 2, 2, 3, 1, 2, 2, 3, 2, 3, 3, 4},
-	{5, 4, 6, 5, 6, 5, 7, 6, 6, 5, 7, 6, 7, 6, 8, 7, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 1, 0, 2, 1, 2, 1, 3, 2, 2, 1, 3, 2, 3, 2, 4, 3},
-	{5, 6, 4, 5, 6, 7, 5, 6, 6, 7, 5, 6, 7, 8, 6, 7, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 1, 2, 0, 1, 2, 3, 1, 2, 2, 3, 1, 2, 3, 4, 2, 3},
-	{6, 5, 5, 4, 7, 6, 6, 5, 7, 6, 6, 5, 8, 7, 7, 6, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 2, 1, 1, 0, 3, 2, 2, 1, 3, 2, 2, 1, 4, 3, 3, 2},
-	{5, 6, 6, 7, 4, 5, 5, 6, 6, 7, 7, 8, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 1, 2, 2, 3, 0, 1, 1, 2, 2, 3, 3, 4, 1, 2, 2, 3},
-	{6, 5, 7, 6, 5, 4, 6, 5, 7, 6, 8, 7, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 2, 1, 3, 2, 1, 0, 2, 1, 3, 2, 4, 3, 2, 1, 3, 2},
-	{6, 7, 5, 6, 5, 6, 4, 5, 7, 8, 6, 7, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 2, 3, 1, 2, 1, 2, 0, 1, 3, 4, 2, 3, 2, 3, 1, 2},
-	{7, 6, 6, 5, 6, 5, 5, 4, 8, 7, 7, 6, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 3, 2, 2, 1, 2, 1, 1, 0, 4, 3, 3, 2, 3, 2, 2, 1},
-	{5, 6, 6, 7, 6, 7, 7, 8, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 4, 5, 5, 6, 5, 6, 6, 7, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 3, 4, 4, 5, 4, 5, 5, 6, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 2, 3, 3, 4, 3, 4, 4, 5, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 0, 1, 1, 2, 1, 2, 2, 3},
-	{6, 5, 7, 6, 7, 6, 8, 7, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 5, 4, 6, 5, 6, 5, 7, 6, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 4, 3, 5, 4, 5, 4, 6, 5, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 3, 2, 4, 3, 4, 3, 5, 4, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 1, 0, 2, 1, 2, 1, 3, 2},
-	{6, 7, 5, 6, 7, 8, 6, 7, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 5, 6, 4, 5, 6, 7, 5, 6, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5, 3, 4, 5, 6, 4, 5, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 3, 4, 2, 3, 4, 5, 3, 4, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 1, 2, 0, 1, 2, 3, 1, 2},
-	{7, 6, 6, 5, 8, 7, 7, 6, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 6, 5, 5, 4, 7, 6, 6, 5, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 5, 4, 4, 3, 6, 5, 5, 4, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 4, 3, 3, 2, 5, 4, 4, 3, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 2, 1, 1, 0, 3, 2, 2, 1},
-	{6, 7, 7, 8, 5, 6, 6, 7, 5, 6, 6, 7, 4, 5, 5, 6, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 5, 6, 6, 7, 4, 5, 5, 6, 4, 5, 5, 6, 3, 4, 4, 5, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 4, 5, 5, 6, 3, 4, 4, 5, 3, 4, 4, 5, 2, 3, 3, 4, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 3, 4, 4, 5, 2, 3, 3, 4, 2, 3, 3, 4, 1, 2, 2, 3, 2, 3, 3, 4, 1, 2, 2, 3, 1, 2, 2, 3, 0, 1, 1, 2},
-	{7, 6, 8, 7, 6, 5, 7, 6, 6, 5, 7, 6, 5, 4, 6, 5, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 6, 5, 7, 6, 5, 4, 6, 5, 5, 4, 6, 5, 4, 3, 5, 4, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 5, 4, 6, 5, 4, 3, 5, 4, 4, 3, 5, 4, 3, 2, 4, 3, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 4, 3, 5, 4, 3, 2, 4, 3, 3, 2, 4, 3, 2, 1, 3, 2, 3, 2, 4, 3, 2, 1, 3, 2, 2, 1, 3, 2, 1, 0, 2, 1},
-	{7, 8, 6, 7, 6, 7, 5, 6, 6, 7, 5, 6, 5, 6, 4, 5, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 6, 7, 5, 6, 5, 6, 4, 5, 5, 6, 4, 5, 4, 5, 3, 4, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 5, 6, 4, 5, 4, 5, 3, 4, 4, 5, 3, 4, 3, 4, 2, 3, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 4, 5, 3, 4, 3, 4, 2, 3, 3, 4, 2, 3, 2, 3, 1, 2, 3, 4, 2, 3, 2, 3, 1, 2, 2, 3, 1, 2, 1, 2, 0, 1},
-	{8, 7, 7, 6, 7, 6, 6, 5, 7, 6, 6, 5, 6, 5, 5, 4, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 7, 6, 6, 5, 6, 5, 5, 4, 6, 5, 5, 4, 5, 4, 4, 3, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 6, 5, 5, 4, 5, 4, 4, 3, 5, 4, 4, 3, 4, 3, 3, 2, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 5, 4, 4, 3, 4, 3, 3, 2, 4, 3, 3, 2, 3, 2, 2, 1, 4, 3, 3, 2, 3, 2, 2, 1, 3, 2, 2, 1, 2, 1, 1, 0},
-}
@@ -0,0 +1,404 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package chunkerstest verifies that a registered algorithm behaves as the
+// chunkers package expects, so that third-party implementations can be
+// checked with the same tests as the bundled ones:
+//
+//	func TestMyAlgorithm(t *testing.T) {
+//		chunkerstest.Run(t, "myalgorithm")
+//	}
+package chunkerstest
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+// MinReuse is the fraction of chunks that must survive a few small insertions
+// in the input for an algorithm to be considered content-defined.
+const MinReuse = 0.75
+
+// Run runs all conformance tests for the algorithm registered as name with
+// its default options.
+func Run(t *testing.T, name string) {
+	t.Helper()
+	RunWithOptions(t, name, nil)
+}
+
+// RunWithOptions runs all conformance tests for the algorithm registered as
+// name with opts, a nil opts selects the default options.
+func RunWithOptions(t *testing.T, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+	RunRegistry(t, chunkers.DefaultRegistry, name, opts)
+}
+
+// RunRegistry is like RunWithOptions for an algorithm registered in registry
+// instead of chunkers.DefaultRegistry.
+func RunRegistry(t *testing.T, registry *chunkers.Registry, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	t.Run("Bounds", func(t *testing.T) { bounds(t, registry, name, opts) })
+	t.Run("Contract", func(t *testing.T) { contract(t, registry, name, opts) })
+	t.Run("Determinism", func(t *testing.T) { determinism(t, registry, name, opts) })
+	t.Run("Fragmentation", func(t *testing.T) { fragmentation(t, registry, name, opts) })
+	t.Run("BoundaryShift", func(t *testing.T) { boundaryShift(t, registry, name, opts) })
+}
+
+// Bounds verifies that chunks are never empty, never above MaxSize, only
+// below MinSize for the last chunk, and that they reassemble into the input.
+func Bounds(t *testing.T, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+	bounds(t, chunkers.DefaultRegistry, name, opts)
+}
+
+func bounds(t *testing.T, registry *chunkers.Registry, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	opts = options(t, registry, name, opts)
+	data := testData(opts)
+	for _, size := range sizes(opts) {
+		checkChunks(t, chunkAll(t, registry, name, bytes.NewReader(data[:size]), opts), data[:size], opts)
+	}
+}
+
+// Contract verifies that Next, Copy and Split follow the contract documented
+// by the chunkers package.
+func Contract(t *testing.T, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+	contract(t, chunkers.DefaultRegistry, name, opts)
+}
+
+func contract(t *testing.T, registry *chunkers.Registry, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	opts = options(t, registry, name, opts)
+	data := testData(opts)
+	for _, size := range sizes(opts) {
+		checkContract(t, registry, name, data[:size], opts)
+	}
+}
+
+// Determinism verifies that the same input always produces the same chunks,
+// including when a chunker is reused with Reset.
+func Determinism(t *testing.T, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+	determinism(t, chunkers.DefaultRegistry, name, opts)
+}
+
+func determinism(t *testing.T, registry *chunkers.Registry, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	opts = options(t, registry, name, opts)
+	data := testData(opts)
+	for _, size := range append(sizes(opts), len(data)) {
+		expected := chunkAll(t, registry, name, bytes.NewReader(data[:size]), opts)
+		checkSameChunks(t, fmt.Sprintf("%d bytes", size), chunkAll(t, registry, name, bytes.NewReader(data[:size]), opts), expected)
+
+		// reusing a chunker must not carry state over from a previous input
+		chunker, err := registry.NewChunker(name, bytes.NewReader(data[len(data)-size:]), opts)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if _, err := chunker.Copy(io.Discard); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		chunker.Reset(bytes.NewReader(data[:size]))
+		var reused [][]byte
+		for chunk, err := range chunker.All() {
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			reused = append(reused, bytes.Clone(chunk.Data))
+		}
+		checkSameChunks(t, fmt.Sprintf("%d bytes after Reset", size), reused, expected)
+	}
+}
+
+// Fragmentation verifies that chunks don't depend on how the input is split
+// across reads.
+func Fragmentation(t *testing.T, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+	fragmentation(t, chunkers.DefaultRegistry, name, opts)
+}
+
+func fragmentation(t *testing.T, registry *chunkers.Registry, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	opts = options(t, registry, name, opts)
+	data := testData(opts)
+	expected := chunkAll(t, registry, name, bytes.NewReader(data), opts)
+	for _, reader := range fragmentedReaders(data) {
+		checkSameChunks(t, reader.name, chunkAll(t, registry, name, reader.rd, opts), expected)
+	}
+}
+
+// BoundaryShift verifies that at least MinReuse of the chunks are found again
+// after inserting a few bytes at several places in the input.
+func BoundaryShift(t *testing.T, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+	boundaryShift(t, chunkers.DefaultRegistry, name, opts)
+}
+
+func boundaryShift(t *testing.T, registry *chunkers.Registry, name string, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	opts = options(t, registry, name, opts)
+	data := testData(opts)
+	original := chunkAll(t, registry, name, bytes.NewReader(data), opts)
+	edited := insertBytes(data, 3)
+	reused := reuse(original, chunkAll(t, registry, name, bytes.NewReader(edited), opts))
+	if reused < MinReuse {
+		t.Fatalf("%s: only %.2f%% of chunks were reused after inserting bytes, expected at least %.2f%%", name, reused*100, MinReuse*100)
+	}
+}
+
+// options returns opts or the default options of the algorithm, after
+// checking that they are valid.
+func options(t *testing.T, registry *chunkers.Registry, name string, opts *chunkers.ChunkerOpts) *chunkers.ChunkerOpts {
+	t.Helper()
+
+	if opts == nil {
+		var err error
+		if opts, err = registry.NewOptions(name); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+	}
+	if err := registry.ValidateOptions(name, opts); err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+	return opts
+}
+
+func testData(opts *chunkers.ChunkerOpts) []byte {
+	return randomData(1, 16*opts.MaxSize+opts.MinSize/2+1)
+}
+
+func randomData(seed int64, size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(seed)).Read(data)
+	return data
+}
+
+// sizes returns input sizes around the bounds of opts.
+func sizes(opts *chunkers.ChunkerOpts) []int {
+	return []int{
+		0,
+		1,
+		opts.MinSize - 1,
+		opts.MinSize,
+		opts.MinSize + 1,
+		opts.MaxSize - 1,
+		opts.MaxSize,
+		opts.MaxSize + 1,
+		2*opts.MaxSize + 7,
+		4*opts.MaxSize + opts.MinSize,
+	}
+}
+
+func chunkAll(t *testing.T, registry *chunkers.Registry, name string, rd io.Reader, opts *chunkers.ChunkerOpts) [][]byte {
+	t.Helper()
+
+	chunker, err := registry.NewChunker(name, rd, opts)
+	if err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+
+	var chunks [][]byte
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			if chunk != nil {
+				t.Fatalf("%s: Next returned data along with io.EOF", name)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		chunks = append(chunks, bytes.Clone(chunk))
+	}
+	return chunks
+}
+
+func checkChunks(t *testing.T, chunks [][]byte, data []byte, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	for i, chunk := range chunks {
+		if len(chunk) == 0 {
+			t.Fatalf("chunk %d is empty", i)
+		}
+		if len(chunk) > opts.MaxSize {
+			t.Fatalf("chunk %d is above MaxSize: %d > %d", i, len(chunk), opts.MaxSize)
+		}
+		if i != len(chunks)-1 && len(chunk) < opts.MinSize {
+			t.Fatalf("chunk %d is below MinSize before the last chunk: %d < %d", i, len(chunk), opts.MinSize)
+		}
+	}
+	if !bytes.Equal(bytes.Join(chunks, nil), data) {
+		t.Fatalf("chunks of a %d bytes input do not reassemble into the input", len(data))
+	}
+}
+
+func checkSameChunks(t *testing.T, what string, chunks, expected [][]byte) {
+	t.Helper()
+
+	if len(chunks) != len(expected) {
+		t.Fatalf("%s: got %d chunks, expected %d", what, len(chunks), len(expected))
+	}
+	for i := range chunks {
+		if !bytes.Equal(chunks[i], expected[i]) {
+			t.Fatalf("%s: chunk %d differs", what, i)
+		}
+	}
+}
+
+func checkContract(t *testing.T, registry *chunkers.Registry, name string, data []byte, opts *chunkers.ChunkerOpts) {
+	t.Helper()
+
+	chunker, err := registry.NewChunker(name, bytes.NewReader(data), opts)
+	if err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+	chunks := chunkAll(t, registry, name, bytes.NewReader(data), opts)
+	for {
+		if _, err := chunker.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if chunk, err := chunker.Next(); chunk != nil || err != io.EOF {
+			t.Fatalf("%s: Next did not keep returning (nil, io.EOF) after the last chunk", name)
+		}
+	}
+
+	var buf bytes.Buffer
+	chunker.Reset(bytes.NewReader(data))
+	nbytes, err := chunker.Copy(&buf)
+	if err != nil {
+		t.Fatalf("%s: Copy returned an error: %s", name, err)
+	}
+	if nbytes != int64(len(data)) || !bytes.Equal(buf.Bytes(), data) {
+		t.Fatalf("%s: Copy wrote %d bytes out of %d", name, nbytes, len(data))
+	}
+
+	i := 0
+	offset := uint(0)
+	chunker.Reset(bytes.NewReader(data))
+	err = chunker.Split(func(chunkOffset, length uint, chunk []byte) error {
+		if i >= len(chunks) || !bytes.Equal(chunk, chunks[i]) {
+			t.Fatalf("%s: Split chunk %d differs from Next", name, i)
+		}
+		if chunkOffset != offset || length != uint(len(chunk)) {
+			t.Fatalf("%s: Split chunk %d is at %d+%d, expected %d+%d", name, i, chunkOffset, length, offset, len(chunk))
+		}
+		i++
+		offset += length
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("%s: Split returned an error: %s", name, err)
+	}
+	if i != len(chunks) {
+		t.Fatalf("%s: Split produced %d chunks, Next produced %d", name, i, len(chunks))
+	}
+
+	errCallback := errors.New("callback error")
+	chunker.Reset(bytes.NewReader(data))
+	err = chunker.Split(func(offset, length uint, chunk []byte) error {
+		return errCallback
+	})
+	if len(data) != 0 && !errors.Is(err, errCallback) {
+		t.Fatalf("%s: Split did not return the callback error: %v", name, err)
+	}
+}
+
+type namedReader struct {
+	name string
+	rd   io.Reader
+}
+
+// randomReader returns reads of random sizes between 1 and max bytes.
+type randomReader struct {
+	rd  io.Reader
+	rnd *rand.Rand
+	max int
+}
+
+func (r *randomReader) Read(p []byte) (int, error) {
+	if n := 1 + r.rnd.Intn(r.max); len(p) > n {
+		p = p[:n]
+	}
+	return r.rd.Read(p)
+}
+
+func fragmentedReaders(data []byte) []namedReader {
+	return []namedReader{
+		{"one byte reads", iotest.OneByteReader(bytes.NewReader(data))},
+		{"half reads", iotest.HalfReader(bytes.NewReader(data))},
+		{"data with io.EOF", iotest.DataErrReader(bytes.NewReader(data))},
+		{"random reads", &randomReader{rd: bytes.NewReader(data), rnd: rand.New(rand.NewSource(2)), max: 4096}},
+		{"small buffered reads", bufio.NewReaderSize(iotest.HalfReader(bytes.NewReader(data)), 16)},
+		{"large buffered reads", bufio.NewReaderSize(bytes.NewReader(data), 1<<20)},
+	}
+}
+
+// insertBytes returns a copy of data with a few random bytes inserted at n
+// evenly spaced positions.
+func insertBytes(data []byte, n int) []byte {
+	rnd := rand.New(rand.NewSource(3))
+	edited := make([]byte, 0, len(data)+n*8)
+	previous := 0
+	for i := 1; i <= n; i++ {
+		position := i * len(data) / (n + 1)
+		edited = append(edited, data[previous:position]...)
+		insertion := make([]byte, 1+rnd.Intn(8))
+		rnd.Read(insertion)
+		edited = append(edited, insertion...)
+		previous = position
+	}
+	return append(edited, data[previous:]...)
+}
+
+// reuse returns the fraction of original chunks that are found in edited.
+func reuse(original, edited [][]byte) float64 {
+	if len(original) == 0 {
+		return 1
+	}
+
+	seen := make(map[[sha256.Size]byte]struct{}, len(edited))
+	for _, chunk := range edited {
+		seen[sha256.Sum256(chunk)] = struct{}{}
+	}
+	reused := 0
+	for _, chunk := range original {
+		if _, exists := seen[sha256.Sum256(chunk)]; exists {
+			reused++
+		}
+	}
+	return float64(reused) / float64(len(original))
+}
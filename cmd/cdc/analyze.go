@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+// sizeConfig holds the sizes of a configuration, all zero for the defaults of
+// the algorithm.
+type sizeConfig struct {
+	min, avg, max size
+}
+
+func parseSizeConfigs(value string) ([]sizeConfig, error) {
+	var configs []sizeConfig
+	for _, spec := range strings.Split(value, ",") {
+		if spec == "default" {
+			configs = append(configs, sizeConfig{})
+			continue
+		}
+
+		fields := strings.Split(spec, "/")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid sizes %q, expected min/avg/max", spec)
+		}
+		var config sizeConfig
+		for i, s := range []*size{&config.min, &config.avg, &config.max} {
+			if err := s.Set(fields[i]); err != nil {
+				return nil, err
+			}
+		}
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+func (config sizeConfig) options() []chunkers.Option {
+	if config == (sizeConfig{}) {
+		return nil
+	}
+	return []chunkers.Option{
+		chunkers.WithMinSize(int(config.min)),
+		chunkers.WithNormalSize(int(config.avg)),
+		chunkers.WithMaxSize(int(config.max)),
+	}
+}
+
+// bucket counts the chunks whose length is at most MaxLength and above the
+// MaxLength of the previous bucket.
+type bucket struct {
+	MaxLength uint64 `json:"max_length"`
+	Chunks    uint64 `json:"chunks"`
+}
+
+// analysis holds the results of chunking a corpus with one configuration.
+type analysis struct {
+	Config       string   `json:"config"`
+	Files        int      `json:"files"`
+	Bytes        uint64   `json:"bytes"`
+	UniqueBytes  uint64   `json:"unique_bytes"`
+	Chunks       uint64   `json:"chunks"`
+	UniqueChunks uint64   `json:"unique_chunks"`
+	DedupRatio   float64  `json:"dedup_ratio"`
+	Throughput   float64  `json:"throughput_mbps"`
+	Memory       uint64   `json:"memory_bytes"`
+	Histogram    []bucket `json:"histogram"`
+}
+
+// analyze chunks files with algorithm and indexes the digests of their chunks
+// in memory. Memory is the heap retained by the chunker and the index once
+// all files have been chunked.
+func analyze(algorithm string, options []chunkers.Option, files []string) (*analysis, error) {
+	var before runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	chunker, err := chunkers.NewChunker(algorithm, nil, options...)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := chunker.Config()
+	if err != nil {
+		return nil, err
+	}
+	result := &analysis{Config: cfg.String(), Files: len(files)}
+
+	index := make(map[[sha256.Size]byte]struct{})
+	histogram := make([]uint64, 33)
+	start := time.Now()
+	for _, name := range files {
+		fp, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		chunker.Reset(fp)
+
+		records := chunker.Records(sha256.New)
+		for {
+			record, err := records.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				fp.Close()
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+
+			result.Bytes += uint64(record.Length)
+			result.Chunks++
+			histogram[bits.Len32(record.Length-1)]++
+
+			digest := [sha256.Size]byte(record.Digest)
+			if _, exists := index[digest]; !exists {
+				index[digest] = struct{}{}
+				result.UniqueBytes += uint64(record.Length)
+				result.UniqueChunks++
+			}
+		}
+		fp.Close()
+	}
+	elapsed := time.Since(start)
+
+	var after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(chunker)
+	runtime.KeepAlive(index)
+	if after.HeapAlloc > before.HeapAlloc {
+		result.Memory = after.HeapAlloc - before.HeapAlloc
+	}
+
+	if result.UniqueBytes != 0 {
+		result.DedupRatio = float64(result.Bytes) / float64(result.UniqueBytes)
+	}
+	if elapsed > 0 {
+		result.Throughput = float64(result.Bytes) / (1 << 20) / elapsed.Seconds()
+	}
+	for i, chunks := range histogram {
+		if chunks != 0 {
+			result.Histogram = append(result.Histogram, bucket{MaxLength: 1 << i, Chunks: chunks})
+		}
+	}
+	return result, nil
+}
+
+// corpus returns the regular files below dir.
+func corpus(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func formatBytes(n uint64) string {
+	switch {
+	case n >= 1<<30:
+		return fmt.Sprintf("%.1fG", float64(n)/(1<<30))
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1fM", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1fK", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+func printTable(w io.Writer, results []*analysis) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "CONFIG\tBYTES\tUNIQUE\tCHUNKS\tUNIQUE CHUNKS\tAVG CHUNK\tDEDUP\tMB/S\tMEMORY\t")
+	for _, result := range results {
+		average := uint64(0)
+		if result.Chunks != 0 {
+			average = result.Bytes / result.Chunks
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%s\t%.3f\t%.1f\t%s\t\n",
+			result.Config, formatBytes(result.Bytes), formatBytes(result.UniqueBytes),
+			result.Chunks, result.UniqueChunks, formatBytes(average),
+			result.DedupRatio, result.Throughput, formatBytes(result.Memory))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		fmt.Fprintf(w, "\n%s\n", result.Config)
+		for _, b := range result.Histogram {
+			fmt.Fprintf(w, "  <= %-6s %10d  %5.1f%%\n", formatBytes(b.MaxLength), b.Chunks, 100*float64(b.Chunks)/float64(result.Chunks))
+		}
+	}
+	return nil
+}
+
+func runAnalyze(args []string, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("analyze", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	algorithms := flags.String("algorithms", strings.Join(chunkers.Algorithms(), ","), "comma-separated algorithms to compare")
+	sizes := flags.String("sizes", "default,4k/16k/64k,64k/256k/1m", "comma-separated min/avg/max sizes to compare, default for the defaults of each algorithm")
+	format := flags.String("format", "table", "output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: cdc analyze [flags] dir\n\nChunks every file below dir with each algorithm and sizes, and reports how\nwell chunks deduplicate.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	if *format != "table" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	configs, err := parseSizeConfigs(*sizes)
+	if err != nil {
+		return err
+	}
+	files, err := corpus(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	names := strings.Split(*algorithms, ",")
+	for _, algorithm := range names {
+		if _, err := chunkers.Describe(algorithm); err != nil {
+			return fmt.Errorf("%s: %s", algorithm, err)
+		}
+	}
+
+	var results []*analysis
+	for _, algorithm := range names {
+		for _, config := range configs {
+			opts, _ := chunkers.NewOptions(algorithm, config.options()...)
+			if err := chunkers.ValidateOptions(algorithm, opts); err != nil {
+				// not every algorithm accepts every size configuration
+				fmt.Fprintf(stderr, "cdc: skipping %s with sizes %d/%d/%d: %s\n", algorithm, config.min, config.avg, config.max, err)
+				continue
+			}
+			result, err := analyze(algorithm, config.options(), files)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+	}
+
+	if *format == "json" {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	}
+	return printTable(stdout, results)
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func Test_ParseSizeConfigs(t *testing.T) {
+	configs, err := parseSizeConfigs("default,4k/16k/64k,1000/2M/1g")
+	if err != nil {
+		t.Fatalf(`parse error: %s`, err)
+	}
+	expected := []sizeConfig{
+		{},
+		{min: 4 << 10, avg: 16 << 10, max: 64 << 10},
+		{min: 1000, avg: 2 << 20, max: 1 << 30},
+	}
+	if !reflect.DeepEqual(configs, expected) {
+		t.Fatalf(`got %+v, expected %+v`, configs, expected)
+	}
+	if configs[0].options() != nil || len(configs[1].options()) != 3 {
+		t.Fatalf(`default sizes don't select the defaults of the algorithm`)
+	}
+
+	for _, value := range []string{"", "4k/16k", "4k/16k/64k/1m", "4k/x/64k", "defaults", "4k/16k/4g"} {
+		if _, err := parseSizeConfigs(value); err == nil {
+			t.Fatalf(`%q was accepted`, value)
+		}
+	}
+}
+
+// writeCorpus writes files to a temporary directory and returns it.
+func writeCorpus(t *testing.T, files map[string][]byte) string {
+	dir := t.TempDir()
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func analyzeCorpus(t *testing.T, dir string) *analysis {
+	files, err := corpus(dir)
+	if err != nil {
+		t.Fatalf(`corpus error: %s`, err)
+	}
+	result, err := analyze("fastcdc", nil, files)
+	if err != nil {
+		t.Fatalf(`analyze error: %s`, err)
+	}
+	return result
+}
+
+// Test_Histogram checks the buckets with files shorter than MinSize, which
+// are a single chunk each.
+func Test_Histogram(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	files := map[string][]byte{}
+	for i, length := range []int{1, 100, 1000, 1024, 1025, 2048} {
+		data := make([]byte, length)
+		rnd.Read(data)
+		files[string(rune('a'+i))] = data
+	}
+
+	result := analyzeCorpus(t, writeCorpus(t, files))
+	expected := []bucket{
+		{MaxLength: 1, Chunks: 1},
+		{MaxLength: 128, Chunks: 1},
+		{MaxLength: 1024, Chunks: 2},
+		{MaxLength: 2048, Chunks: 2},
+	}
+	if !reflect.DeepEqual(result.Histogram, expected) {
+		t.Fatalf(`got histogram %+v, expected %+v`, result.Histogram, expected)
+	}
+	if result.Files != 6 || result.Chunks != 6 || result.Bytes != 1+100+1000+1024+1025+2048 {
+		t.Fatalf(`got %d files, %d chunks and %d bytes`, result.Files, result.Chunks, result.Bytes)
+	}
+}
+
+// Test_DedupRatio checks the unique bytes and dedup ratio of a corpus holding
+// copies of the same files.
+func Test_DedupRatio(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	first := make([]byte, 1<<20)
+	rnd.Read(first)
+	second := make([]byte, 512<<10)
+	rnd.Read(second)
+
+	dir := writeCorpus(t, map[string][]byte{
+		"first":             first,
+		"copy/first":        first,
+		"copy/nested/first": first,
+		"second":            second,
+		"copy/second":       second,
+	})
+	result := analyzeCorpus(t, dir)
+
+	if result.Files != 5 {
+		t.Fatalf(`got %d files, expected 5`, result.Files)
+	}
+	if result.Bytes != 4<<20 {
+		t.Fatalf(`got %d bytes, expected %d`, result.Bytes, 4<<20)
+	}
+	if result.UniqueBytes != 1536<<10 {
+		t.Fatalf(`got %d unique bytes, expected %d`, result.UniqueBytes, 1536<<10)
+	}
+
+	firstChunks := analyzeCorpus(t, writeCorpus(t, map[string][]byte{"first": first})).Chunks
+	secondChunks := analyzeCorpus(t, writeCorpus(t, map[string][]byte{"second": second})).Chunks
+	if result.Chunks != 3*firstChunks+2*secondChunks || result.UniqueChunks != firstChunks+secondChunks {
+		t.Fatalf(`got %d unique chunks out of %d, expected %d out of %d`,
+			result.UniqueChunks, result.Chunks, firstChunks+secondChunks, 3*firstChunks+2*secondChunks)
+	}
+	if expected := float64(4<<20) / float64(1536<<10); result.DedupRatio != expected {
+		t.Fatalf(`got dedup ratio %f, expected %f`, result.DedupRatio, expected)
+	}
+}
+
+// duplicatesCorpus writes a corpus of 2.5MB holding 1.5MB of unique data.
+func duplicatesCorpus(t *testing.T) string {
+	rnd := rand.New(rand.NewSource(1))
+	first := make([]byte, 1<<20)
+	rnd.Read(first)
+	second := make([]byte, 512<<10)
+	rnd.Read(second)
+
+	return writeCorpus(t, map[string][]byte{
+		"first":      first,
+		"copy/first": first,
+		"second":     second,
+	})
+}
+
+func Test_RunAnalyze_JSON(t *testing.T) {
+	dir := duplicatesCorpus(t)
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"-algorithms", "fastcdc,rabin", "-sizes", "default,1k/3k/8k", "-format", "json", dir}
+	if err := runAnalyze(args, &stdout, &stderr); err != nil {
+		t.Fatalf(`analyze error: %s %s`, err, stderr.String())
+	}
+
+	// rabin requires a power of two NormalSize
+	if !strings.Contains(stderr.String(), "skipping rabin with sizes 1024/3072/8192") {
+		t.Fatalf(`invalid sizes were not skipped: %q`, stderr.String())
+	}
+
+	var results []analysis
+	if err := json.Unmarshal(stdout.Bytes(), &results); err != nil {
+		t.Fatalf(`invalid json: %s`, err)
+	}
+	rabin, _ := chunkers.NewConfig("rabin", nil)
+	var configs []string
+	for _, result := range results {
+		configs = append(configs, result.Config)
+		if result.Files != 3 || result.Bytes != 2560<<10 || result.UniqueBytes != 1536<<10 {
+			t.Fatalf(`%s: got %d files, %d bytes and %d unique bytes`, result.Config, result.Files, result.Bytes, result.UniqueBytes)
+		}
+		if result.DedupRatio != float64(2560)/1536 || len(result.Histogram) == 0 {
+			t.Fatalf(`%s: got dedup ratio %f and histogram %v`, result.Config, result.DedupRatio, result.Histogram)
+		}
+	}
+	expected := []string{"fastcdc@v2:min=2k,avg=8k,max=64k,level=2", "fastcdc@v2:min=1k,avg=3k,max=8k,level=2", rabin.String()}
+	if !reflect.DeepEqual(configs, expected) {
+		t.Fatalf(`got configs %v, expected %v`, configs, expected)
+	}
+}
+
+func Test_RunAnalyze_Table(t *testing.T) {
+	dir := duplicatesCorpus(t)
+
+	var stdout, stderr bytes.Buffer
+	if err := runAnalyze([]string{"-algorithms", "fastcdc", "-sizes", "default", dir}, &stdout, &stderr); err != nil {
+		t.Fatalf(`analyze error: %s %s`, err, stderr.String())
+	}
+
+	lines := strings.Split(stdout.String(), "\n")
+	header := strings.Fields(lines[0])
+	if !reflect.DeepEqual(header[:3], []string{"CONFIG", "BYTES", "UNIQUE"}) {
+		t.Fatalf(`invalid header %q`, lines[0])
+	}
+	row := strings.Fields(lines[1])
+	if row[0] != "fastcdc@v2:min=2k,avg=8k,max=64k,level=2" || row[1] != "2.5M" || row[2] != "1.5M" || row[6] != "1.667" {
+		t.Fatalf(`invalid row %q`, lines[1])
+	}
+	if !strings.Contains(stdout.String(), "\nfastcdc@v2:min=2k,avg=8k,max=64k,level=2\n  <= ") {
+		t.Fatalf(`no histogram in %q`, stdout.String())
+	}
+}
+
+func Test_RunAnalyze_Errors(t *testing.T) {
+	dir := duplicatesCorpus(t)
+
+	for _, args := range [][]string{
+		nil,
+		{dir, dir},
+		{"-unknown", dir},
+		{"-format", "xml", dir},
+		{"-algorithms", "fastcdc,unknown", dir},
+		{"-sizes", "4k/16k", dir},
+		{filepath.Join(dir, "missing")},
+	} {
+		var stdout, stderr bytes.Buffer
+		if err := runAnalyze(args, &stdout, &stderr); err == nil {
+			t.Fatalf(`cdc analyze %s succeeded`, strings.Join(args, " "))
+		}
+	}
+
+	// a file that can't be read fails the analysis instead of exiting
+	missing := filepath.Join(dir, "missing")
+	if _, err := analyze("fastcdc", nil, []string{missing}); err == nil || !strings.Contains(err.Error(), missing) {
+		t.Fatalf(`got error %v for an unreadable file`, err)
+	}
+}
@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Command cdc chunks files, or its standard input, and prints the offset,
+// length and digest of each chunk.
+//
+//	cdc [-algorithm name] [-min size] [-avg size] [-max size] [-format text|csv|jsonl] [file ...]
+//
+// The analyze subcommand compares how well algorithms and sizes deduplicate
+// the files below a directory.
+//
+//	cdc analyze [-algorithms names] [-sizes min/avg/max,...] [-format table|json] dir
+package main
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"os"
+	"strconv"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/buzhash"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/fastcdc"
+	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/jc"
+	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/rabin"
+	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/ultracdc"
+)
+
+var hashes = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// size is a flag accepting sizes with k, m and g suffixes.
+type size int
+
+func (s *size) String() string {
+	return strconv.Itoa(int(*s))
+}
+
+func (s *size) Set(value string) error {
+	n, err := chunkers.ParseSize(value)
+	if err != nil {
+		return fmt.Errorf("invalid size %q", value)
+	}
+	*s = size(n)
+	return nil
+}
+
+// printer writes the record of each chunk in one of the output formats.
+type printer interface {
+	print(name string, record chunkers.ChunkRecord) error
+	flush() error
+}
+
+type textPrinter struct {
+	w *bufio.Writer
+}
+
+func (p *textPrinter) print(name string, record chunkers.ChunkRecord) error {
+	_, err := fmt.Fprintf(p.w, "%s\t%d\t%d\t%x\n", name, record.Offset, record.Length, record.Digest)
+	return err
+}
+
+func (p *textPrinter) flush() error {
+	return p.w.Flush()
+}
+
+type csvPrinter struct {
+	w *csv.Writer
+}
+
+func (p *csvPrinter) print(name string, record chunkers.ChunkRecord) error {
+	return p.w.Write([]string{
+		name,
+		strconv.FormatUint(record.Offset, 10),
+		strconv.FormatUint(uint64(record.Length), 10),
+		hex.EncodeToString(record.Digest),
+	})
+}
+
+func (p *csvPrinter) flush() error {
+	p.w.Flush()
+	return p.w.Error()
+}
+
+type jsonPrinter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func (p *jsonPrinter) print(name string, record chunkers.ChunkRecord) error {
+	return p.enc.Encode(struct {
+		File   string `json:"file"`
+		Offset uint64 `json:"offset"`
+		Length uint32 `json:"length"`
+		Hash   string `json:"hash"`
+	}{name, record.Offset, record.Length, hex.EncodeToString(record.Digest)})
+}
+
+func (p *jsonPrinter) flush() error {
+	return p.w.Flush()
+}
+
+func newPrinter(format string, w io.Writer) (printer, error) {
+	bw := bufio.NewWriter(w)
+	switch format {
+	case "text":
+		return &textPrinter{w: bw}, nil
+	case "csv":
+		p := &csvPrinter{w: csv.NewWriter(bw)}
+		if err := p.w.Write([]string{"file", "offset", "length", "hash"}); err != nil {
+			return nil, err
+		}
+		return p, nil
+	case "jsonl":
+		return &jsonPrinter{w: bw, enc: json.NewEncoder(bw)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// chunk prints the records of the chunks of rd.
+func chunk(chunker *chunkers.Chunker, hashFactory func() hash.Hash, p printer, name string, rd io.Reader) error {
+	chunker.Reset(rd)
+
+	records := chunker.Records(hashFactory)
+	for {
+		record, err := records.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := p.print(name, record); err != nil {
+			return err
+		}
+	}
+}
+
+// errUsage is returned by run and runAnalyze when the flags can't be parsed,
+// the flag set has already reported it along with the usage.
+var errUsage = errors.New("usage")
+
+// usesLevel reports whether algorithm accepts a NormalizationLevel.
+func usesLevel(algorithm string) (bool, error) {
+	description, err := chunkers.Describe(algorithm)
+	if err != nil {
+		return false, err
+	}
+	for _, constraint := range description.Constraints {
+		if constraint.Field == "NormalizationLevel" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	var (
+		algorithm string
+		config    string
+		minSize   size
+		avgSize   size
+		maxSize   size
+		level     int
+		key       string
+		hashName  string
+		format    string
+		list      bool
+	)
+	flags := flag.NewFlagSet("cdc", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&algorithm, "algorithm", "fastcdc", "chunking algorithm, see -list")
+	flags.StringVar(&config, "config", "", "chunker config such as fastcdc:min=2k,avg=8k,max=64k, overrides the other chunker flags")
+	flags.Var(&minSize, "min", "minimum chunk size, accepts k, m and g suffixes")
+	flags.Var(&avgSize, "avg", "normal chunk size, accepts k, m and g suffixes")
+	flags.Var(&maxSize, "max", "maximum chunk size, accepts k, m and g suffixes")
+	flags.IntVar(&level, "level", 0, "normalization level of the fastcdc algorithms")
+	flags.StringVar(&key, "key", "", "hex-encoded 32-byte key for keyed chunking")
+	flags.StringVar(&hashName, "hash", "sha256", "chunk digest: md5, sha1, sha256 or sha512")
+	flags.StringVar(&format, "format", "text", "output format: text, csv or jsonl")
+	flags.BoolVar(&list, "list", false, "list the available algorithms and exit")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: cdc [flags] [file ...]\n       cdc analyze [flags] dir\n\nChunks the files, or the standard input, and prints the file, offset, length\nand digest of each chunk. Sizes default to those of the algorithm.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+
+	if list {
+		for _, name := range chunkers.Algorithms() {
+			cfg, err := chunkers.NewConfig(name, nil)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(stdout, cfg)
+		}
+		return nil
+	}
+
+	var options []chunkers.Option
+	var err error
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "min":
+			options = append(options, chunkers.WithMinSize(int(minSize)))
+		case "avg":
+			options = append(options, chunkers.WithNormalSize(int(avgSize)))
+		case "max":
+			options = append(options, chunkers.WithMaxSize(int(maxSize)))
+		case "level":
+			var accepted bool
+			if accepted, err = usesLevel(algorithm); err == nil && !accepted {
+				err = fmt.Errorf("%s has no normalization level", algorithm)
+			}
+			options = append(options, fastcdc.WithNormalization(level))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	var keyOption []chunkers.Option
+	if key != "" {
+		k, err := hex.DecodeString(key)
+		if err != nil {
+			return fmt.Errorf("invalid key: %s", err)
+		}
+		keyOption = append(keyOption, chunkers.WithKey(k))
+	}
+
+	var chunker *chunkers.Chunker
+	if config != "" {
+		var cfg chunkers.Config
+		if err := cfg.UnmarshalText([]byte(config)); err != nil {
+			return err
+		}
+		chunker, err = chunkers.NewChunkerFromConfig(&cfg, nil, keyOption...)
+	} else {
+		chunker, err = chunkers.NewChunker(algorithm, nil, append(options, keyOption...)...)
+	}
+	if err != nil {
+		return err
+	}
+
+	hashFactory, exists := hashes[hashName]
+	if !exists {
+		return fmt.Errorf("unknown hash %q", hashName)
+	}
+
+	p, err := newPrinter(format, stdout)
+	if err != nil {
+		return err
+	}
+
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	for _, name := range files {
+		if name == "-" {
+			err = chunk(chunker, hashFactory, p, name, stdin)
+		} else {
+			var fp *os.File
+			if fp, err = os.Open(name); err == nil {
+				err = chunk(chunker, hashFactory, p, name, fp)
+				fp.Close()
+			}
+		}
+		if err != nil {
+			p.flush()
+			return err
+		}
+	}
+	return p.flush()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("cdc: ")
+
+	var err error
+	if len(os.Args) > 1 && os.Args[1] == "analyze" {
+		err = runAnalyze(os.Args[2:], os.Stdout, os.Stderr)
+	} else {
+		err = run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	}
+	switch err {
+	case nil:
+	case flag.ErrHelp:
+		os.Exit(0)
+	case errUsage:
+		os.Exit(2)
+	default:
+		log.Fatal(err)
+	}
+}
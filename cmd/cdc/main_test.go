@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// output runs cdc with args and stdin, and returns what it printed.
+func output(t *testing.T, stdin []byte, args ...string) string {
+	var stdout, stderr bytes.Buffer
+	if err := run(args, bytes.NewReader(stdin), &stdout, &stderr); err != nil {
+		t.Fatalf(`cdc %s: %s %s`, strings.Join(args, " "), err, stderr.String())
+	}
+	return stdout.String()
+}
+
+type record struct {
+	file   string
+	offset int
+	length int
+	hash   string
+}
+
+// parse returns the records printed in format.
+func parse(t *testing.T, format string, out string) []record {
+	var records []record
+	switch format {
+	case "text":
+		for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+			var r record
+			if _, err := fmt.Sscanf(line, "%s\t%d\t%d\t%s", &r.file, &r.offset, &r.length, &r.hash); err != nil {
+				t.Fatalf(`invalid text line %q: %s`, line, err)
+			}
+			records = append(records, r)
+		}
+	case "csv":
+		rows, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+		if err != nil {
+			t.Fatalf(`invalid csv: %s`, err)
+		}
+		if strings.Join(rows[0], ",") != "file,offset,length,hash" {
+			t.Fatalf(`invalid csv header %q`, rows[0])
+		}
+		for _, row := range rows[1:] {
+			offset, _ := strconv.Atoi(row[1])
+			length, _ := strconv.Atoi(row[2])
+			records = append(records, record{row[0], offset, length, row[3]})
+		}
+	case "jsonl":
+		decoder := json.NewDecoder(strings.NewReader(out))
+		for decoder.More() {
+			var r struct {
+				File   string `json:"file"`
+				Offset int    `json:"offset"`
+				Length int    `json:"length"`
+				Hash   string `json:"hash"`
+			}
+			if err := decoder.Decode(&r); err != nil {
+				t.Fatalf(`invalid jsonl: %s`, err)
+			}
+			records = append(records, record{r.File, r.Offset, r.Length, r.Hash})
+		}
+	}
+	return records
+}
+
+// checkRecords checks that records cover data with the digests of its chunks.
+func checkRecords(t *testing.T, records []record, name string, data []byte) {
+	offset := 0
+	for _, r := range records {
+		if r.file != name || r.offset != offset {
+			t.Fatalf(`got record %+v at offset %d of %s`, r, offset, name)
+		}
+		digest := sha256.Sum256(data[r.offset : r.offset+r.length])
+		if r.hash != hex.EncodeToString(digest[:]) {
+			t.Fatalf(`record %+v has the wrong digest`, r)
+		}
+		offset += r.length
+	}
+	if offset != len(data) {
+		t.Fatalf(`records cover %d bytes out of %d`, offset, len(data))
+	}
+}
+
+func testFile(t *testing.T) (string, []byte) {
+	data := make([]byte, 1<<20)
+	rand.New(rand.NewSource(1)).Read(data)
+	name := filepath.Join(t.TempDir(), "data")
+	if err := os.WriteFile(name, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return name, data
+}
+
+func Test_Formats(t *testing.T) {
+	name, data := testFile(t)
+
+	var expected []record
+	for _, format := range []string{"text", "csv", "jsonl"} {
+		records := parse(t, format, output(t, nil, "-format", format, name))
+		checkRecords(t, records, name, data)
+		if expected != nil && fmt.Sprint(records) != fmt.Sprint(expected) {
+			t.Fatalf(`%s records differ from the text ones`, format)
+		}
+		expected = records
+	}
+}
+
+func Test_Stdin(t *testing.T) {
+	name, data := testFile(t)
+
+	fromFile := parse(t, "text", output(t, nil, name))
+	for _, args := range [][]string{nil, {"-"}} {
+		records := parse(t, "text", output(t, data, args...))
+		checkRecords(t, records, "-", data)
+		if len(records) != len(fromFile) {
+			t.Fatalf(`standard input has %d chunks, the file has %d`, len(records), len(fromFile))
+		}
+	}
+}
+
+func Test_Config(t *testing.T) {
+	name, _ := testFile(t)
+
+	for _, test := range []struct {
+		config string
+		flags  []string
+	}{
+		{"fastcdc", nil},
+		{"fastcdc:min=2k,avg=8k,max=64k", nil},
+		{"fastcdc:min=4k,avg=16k,max=128k,level=3", []string{"-min", "4k", "-avg", "16k", "-max", "128k", "-level", "3"}},
+		{"jc@v2:min=1k", []string{"-algorithm", "jc", "-min", "1k"}},
+	} {
+		expected := output(t, nil, append(test.flags, name)...)
+		if got := output(t, nil, "-config", test.config, name); got != expected {
+			t.Fatalf(`-config %s doesn't produce the records of %v`, test.config, test.flags)
+		}
+	}
+}
+
+func Test_Errors(t *testing.T) {
+	for _, args := range [][]string{
+		{"-algorithm", "jc", "-level", "3"},
+		{"-algorithm", "unknown", "-level", "3"},
+		{"-algorithm", "unknown"},
+		{"-min", "4x"},
+		{"-config", "fastcdc@v1"},
+		{"-format", "xml"},
+		{"-hash", "crc32"},
+		{"-key", "zz"},
+		{"missing"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if err := run(args, bytes.NewReader(nil), &stdout, &stderr); err == nil {
+			t.Fatalf(`cdc %s succeeded`, strings.Join(args, " "))
+		}
+	}
+}
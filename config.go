@@ -0,0 +1,368 @@
+package chunkers
+
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrVersionMismatch = errors.New("boundary version mismatch")
+	ErrKeyMismatch     = errors.New("key doesn't match config")
+)
+
+// Config records how data was chunked so that an identical chunker can be
+// created later with NewChunkerFromConfig. It holds the options of a
+// ChunkerOpts except for the key, which is secret and only recorded as the
+// Keyed flag, and for Extra.
+//
+// Its text form is the algorithm name, optionally followed by @ and the
+// boundary version, then by a colon and comma-separated options:
+//
+//	fastcdc@v2:min=2k,avg=8k,max=64k,level=2,keyed
+//
+// Sizes accept k, m and g suffixes, options left out select the defaults of
+// the algorithm. The JSON form is an object with the same fields, a JSON string
+// holding the text form is also accepted.
+type Config struct {
+	Algorithm string
+	Version   string
+
+	MinSize            int
+	MaxSize            int
+	NormalSize         int
+	NormalizationLevel int
+	Polynomial         uint64
+	WindowSize         int
+	Seed               uint32
+	Keyed              bool
+}
+
+// NewConfig returns the config of a chunker created with algorithm and opts,
+// a nil opts selects the default options.
+func NewConfig(algorithm string, opts *ChunkerOpts) (*Config, error) {
+	return DefaultRegistry.NewConfig(algorithm, opts)
+}
+
+// NewConfig is like the package-level NewConfig for the algorithms of r.
+func (r *Registry) NewConfig(algorithm string, opts *ChunkerOpts) (*Config, error) {
+	implementationAllocator, err := r.lookup(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	implementation := implementationAllocator()
+	if opts == nil {
+		opts = implementation.DefaultOptions()
+	}
+	if err := implementation.Validate(opts); err != nil {
+		return nil, err
+	}
+	return newConfig(algorithm, implementation, opts)
+}
+
+func newConfig(algorithm string, implementation ChunkerImplementation, opts *ChunkerOpts) (*Config, error) {
+	if len(opts.Extra) != 0 {
+		return nil, errors.New("extra options can't be stored in a config")
+	}
+
+	resolved := *opts
+	resolveOptions(implementation, &resolved)
+	opts = &resolved
+
+	cfg := &Config{
+		Algorithm:          algorithm,
+		MinSize:            opts.MinSize,
+		MaxSize:            opts.MaxSize,
+		NormalSize:         opts.NormalSize,
+		NormalizationLevel: opts.NormalizationLevel,
+		Polynomial:         opts.Polynomial,
+		WindowSize:         opts.WindowSize,
+		Seed:               opts.Seed,
+		Keyed:              len(opts.Key) != 0,
+	}
+	if versioner, ok := implementation.(Versioner); ok {
+		cfg.Version = versioner.Version()
+	}
+	return cfg, nil
+}
+
+// Config returns the config of the chunker.
+func (chunker *Chunker) Config() (*Config, error) {
+	return newConfig(chunker.algorithm, chunker.implementation, chunker.options)
+}
+
+// Options returns the default options of the algorithm overridden by those
+// recorded in the config, without a key.
+func (cfg *Config) Options() (*ChunkerOpts, error) {
+	return DefaultRegistry.NewOptions(cfg.Algorithm, cfg)
+}
+
+// apply overrides the options recorded in the config, the others are left
+// unchanged.
+func (cfg *Config) apply(opts *ChunkerOpts) {
+	if cfg.MinSize != 0 {
+		opts.MinSize = cfg.MinSize
+	}
+	if cfg.MaxSize != 0 {
+		opts.MaxSize = cfg.MaxSize
+	}
+	if cfg.NormalSize != 0 {
+		opts.NormalSize = cfg.NormalSize
+	}
+	if cfg.NormalizationLevel != 0 {
+		opts.NormalizationLevel = cfg.NormalizationLevel
+	}
+	if cfg.Polynomial != 0 {
+		opts.Polynomial = cfg.Polynomial
+	}
+	if cfg.WindowSize != 0 {
+		opts.WindowSize = cfg.WindowSize
+	}
+	if cfg.Seed != 0 {
+		opts.Seed = cfg.Seed
+	}
+}
+
+// NewChunkerFromConfig returns a chunker reading from reader which produces
+// the same boundaries as the chunker cfg was recorded from. The key of a keyed
+// config is passed with WithKey in options, which are applied after the
+// config.
+//
+// It fails with ErrVersionMismatch if the boundary version of the algorithm
+// differs from the recorded one, and with ErrKeyMismatch if a key is passed
+// for an unkeyed config or missing for a keyed one.
+func NewChunkerFromConfig(cfg *Config, reader io.Reader, options ...Option) (*Chunker, error) {
+	return DefaultRegistry.NewChunkerFromConfig(cfg, reader, options...)
+}
+
+// NewChunkerFromConfig is like the package-level NewChunkerFromConfig for the
+// algorithms of r.
+func (r *Registry) NewChunkerFromConfig(cfg *Config, reader io.Reader, options ...Option) (*Chunker, error) {
+	version, err := r.Version(cfg.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Version != "" && cfg.Version != version {
+		return nil, fmt.Errorf("%w: %s is %s, config requires %s", ErrVersionMismatch, cfg.Algorithm, version, cfg.Version)
+	}
+
+	opts, err := r.NewOptions(cfg.Algorithm, append([]Option{cfg}, options...)...)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Keyed != (len(opts.Key) != 0) {
+		return nil, ErrKeyMismatch
+	}
+	return r.NewChunker(cfg.Algorithm, reader, opts)
+}
+
+func (cfg *Config) String() string {
+	text, _ := cfg.MarshalText()
+	return string(text)
+}
+
+func (cfg *Config) MarshalText() ([]byte, error) {
+	if cfg.Algorithm == "" || strings.ContainsAny(cfg.Algorithm, "@:") {
+		return nil, fmt.Errorf("invalid algorithm name %q", cfg.Algorithm)
+	}
+	if strings.ContainsAny(cfg.Version, "@:") {
+		return nil, fmt.Errorf("invalid version %q", cfg.Version)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(cfg.Algorithm)
+	if cfg.Version != "" {
+		sb.WriteString("@" + cfg.Version)
+	}
+
+	var fields []string
+	if cfg.MinSize != 0 {
+		fields = append(fields, "min="+formatSize(cfg.MinSize))
+	}
+	if cfg.NormalSize != 0 {
+		fields = append(fields, "avg="+formatSize(cfg.NormalSize))
+	}
+	if cfg.MaxSize != 0 {
+		fields = append(fields, "max="+formatSize(cfg.MaxSize))
+	}
+	switch cfg.NormalizationLevel {
+	case 0:
+	case NormalizationNone:
+		fields = append(fields, "level=0")
+	default:
+		fields = append(fields, "level="+strconv.Itoa(cfg.NormalizationLevel))
+	}
+	if cfg.Polynomial != 0 {
+		fields = append(fields, "poly=0x"+strconv.FormatUint(cfg.Polynomial, 16))
+	}
+	if cfg.WindowSize != 0 {
+		fields = append(fields, "window="+strconv.Itoa(cfg.WindowSize))
+	}
+	if cfg.Seed != 0 {
+		fields = append(fields, "seed="+strconv.FormatUint(uint64(cfg.Seed), 10))
+	}
+	if cfg.Keyed {
+		fields = append(fields, "keyed")
+	}
+	if len(fields) != 0 {
+		sb.WriteString(":" + strings.Join(fields, ","))
+	}
+	return []byte(sb.String()), nil
+}
+
+func (cfg *Config) UnmarshalText(text []byte) error {
+	spec := string(text)
+	parsed := Config{}
+
+	name, fields, hasFields := strings.Cut(spec, ":")
+	parsed.Algorithm, parsed.Version, _ = strings.Cut(name, "@")
+	if parsed.Algorithm == "" {
+		return fmt.Errorf("invalid config %q: missing algorithm", spec)
+	}
+	if hasFields && fields == "" {
+		return fmt.Errorf("invalid config %q: missing options", spec)
+	}
+
+	var options []string
+	if hasFields {
+		options = strings.Split(fields, ",")
+	}
+
+	seen := map[string]bool{}
+	for _, field := range options {
+		key, value, _ := strings.Cut(field, "=")
+		if seen[key] {
+			return fmt.Errorf("invalid config %q: duplicate %s", spec, key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "min":
+			parsed.MinSize, err = ParseSize(value)
+		case "avg":
+			parsed.NormalSize, err = ParseSize(value)
+		case "max":
+			parsed.MaxSize, err = ParseSize(value)
+		case "level":
+			parsed.NormalizationLevel, err = strconv.Atoi(value)
+			if err == nil && parsed.NormalizationLevel == 0 {
+				parsed.NormalizationLevel = NormalizationNone
+			}
+		case "poly":
+			parsed.Polynomial, err = strconv.ParseUint(value, 0, 64)
+		case "window":
+			parsed.WindowSize, err = strconv.Atoi(value)
+		case "seed":
+			var seed uint64
+			seed, err = strconv.ParseUint(value, 0, 32)
+			parsed.Seed = uint32(seed)
+		case "keyed":
+			if field != "keyed" {
+				err = errors.New("keyed takes no value")
+			}
+			parsed.Keyed = true
+		default:
+			err = errors.New("unknown option")
+		}
+		if err != nil {
+			return fmt.Errorf("invalid config %q: %s: %w", spec, key, err)
+		}
+	}
+
+	*cfg = parsed
+	return nil
+}
+
+type configJSON struct {
+	Algorithm          string `json:"algorithm"`
+	Version            string `json:"version,omitempty"`
+	MinSize            int    `json:"min_size,omitempty"`
+	MaxSize            int    `json:"max_size,omitempty"`
+	NormalSize         int    `json:"normal_size,omitempty"`
+	NormalizationLevel int    `json:"normalization_level,omitempty"`
+	Polynomial         uint64 `json:"polynomial,omitempty"`
+	WindowSize         int    `json:"window_size,omitempty"`
+	Seed               uint32 `json:"seed,omitempty"`
+	Keyed              bool   `json:"keyed,omitempty"`
+}
+
+func (cfg *Config) MarshalJSON() ([]byte, error) {
+	return json.Marshal(configJSON(*cfg))
+}
+
+func (cfg *Config) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return cfg.UnmarshalText([]byte(text))
+	}
+
+	var parsed configJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+	if parsed.Algorithm == "" {
+		return errors.New("invalid config: missing algorithm")
+	}
+	*cfg = Config(parsed)
+	return nil
+}
+
+var sizeSuffixes = []struct {
+	suffix string
+	shift  uint
+}{
+	{"g", 30},
+	{"m", 20},
+	{"k", 10},
+}
+
+func formatSize(size int) string {
+	for _, s := range sizeSuffixes {
+		if size%(1<<s.shift) == 0 {
+			return strconv.Itoa(size>>s.shift) + s.suffix
+		}
+	}
+	return strconv.Itoa(size)
+}
+
+// ParseSize parses a size as found in the text form of a Config, a decimal
+// number of bytes optionally followed by a k, m or g suffix.
+func ParseSize(value string) (int, error) {
+	shift := uint(0)
+	for _, s := range sizeSuffixes {
+		if trimmed, found := strings.CutSuffix(strings.ToLower(value), s.suffix); found {
+			value, shift = trimmed, s.shift
+			break
+		}
+	}
+
+	size, err := strconv.ParseUint(value, 10, 31)
+	if err != nil {
+		return 0, err
+	}
+	if size > (1<<31-1)>>shift {
+		return 0, strconv.ErrRange
+	}
+	return int(size << shift), nil
+}
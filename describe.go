@@ -0,0 +1,74 @@
+package chunkers
+
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Keyer is implemented by algorithms that support ChunkerOpts.Key.
+type Keyer interface {
+	Keyed() bool
+}
+
+// Constrainer is implemented by algorithms that document the constraints
+// their Validate method enforces on options.
+type Constrainer interface {
+	Constraints() []Constraint
+}
+
+// Constraint describes the values accepted for an option, Rule is meant to be
+// read by humans and may refer to other options.
+type Constraint struct {
+	Field string
+	Rule  string
+}
+
+// Description holds the metadata of a registered algorithm.
+type Description struct {
+	Name           string
+	Version        string
+	Keyed          bool
+	DefaultOptions *ChunkerOpts
+	Constraints    []Constraint
+}
+
+// Describe returns the metadata of algorithm, the version, keyed flag and
+// constraints are left empty for algorithms that don't report them.
+func Describe(algorithm string) (*Description, error) {
+	return DefaultRegistry.Describe(algorithm)
+}
+
+// Describe is like the package-level Describe for the algorithms of r.
+func (r *Registry) Describe(algorithm string) (*Description, error) {
+	implementationAllocator, err := r.lookup(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	implementation := implementationAllocator()
+	description := &Description{
+		Name:           algorithm,
+		DefaultOptions: implementation.DefaultOptions(),
+	}
+	if versioner, ok := implementation.(Versioner); ok {
+		description.Version = versioner.Version()
+	}
+	if keyer, ok := implementation.(Keyer); ok {
+		description.Keyed = keyer.Keyed()
+	}
+	if constrainer, ok := implementation.(Constrainer); ok {
+		description.Constraints = constrainer.Constraints()
+	}
+	return description, nil
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package chunkers provides a unified interface to Content-Defined Chunking
+// algorithms, which register themselves with Register when their package is
+// imported.
+//
+// Chunkers follow this contract, identified by ContractVersion:
+//
+//   - Next returns every chunk, including the last one, with a nil error.
+//     Chunks are never empty, never larger than MaxSize and only the last
+//     chunk may be smaller than MinSize. Once the input is exhausted, Next
+//     returns (nil, io.EOF) on every call.
+//   - Copy writes every chunk and returns the total number of bytes written
+//     along with a nil error, or the bytes written so far and the first error
+//     from the reader or the writer.
+//   - Split passes every chunk to its callback and returns nil, or the first
+//     error from the reader or the callback.
+//   - The concatenation of all chunks is the input.
+//
+// Version 1 of the contract returned the last chunk along with io.EOF when it
+// was shorter than MinSize, and Copy returned io.EOF without counting the last
+// chunk.
+package chunkers
+
+// ContractVersion is the version of the contract documented above.
+const ContractVersion = 2
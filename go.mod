@@ -1,6 +1,6 @@
 module github.com/PlakarLabs/go-cdc-chunkers
 
-go 1.20
+go 1.23
 
 require (
 	codeberg.org/mhofmann/fastcdc v1.0.0
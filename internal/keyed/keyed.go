@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package keyed derives the per-key tables used by the keyed variants of
+// the chunking algorithms.
+package keyed
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+)
+
+var gearTables sync.Map
+
+// Stream returns n bytes deterministically derived from key and label,
+// using HMAC-SHA256 in counter mode.
+func Stream(key []byte, label string, n int) []byte {
+	out := make([]byte, 0, n+sha256.Size)
+	mac := hmac.New(sha256.New, key)
+	var counter [4]byte
+	for i := uint32(0); len(out) < n; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		mac.Reset()
+		mac.Write([]byte(label))
+		mac.Write(counter[:])
+		out = mac.Sum(out)
+	}
+	return out[:n]
+}
+
+// Uint64s returns n 64-bit values derived from key and label.
+func Uint64s(key []byte, label string, n int) []uint64 {
+	stream := Stream(key, label, n*8)
+	values := make([]uint64, n)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint64(stream[i*8:])
+	}
+	return values
+}
+
+// Permutation returns a permutation of all byte values derived from key
+// and label.
+func Permutation(key []byte, label string) *[256]byte {
+	var perm [256]byte
+	for i := range perm {
+		perm[i] = byte(i)
+	}
+	values := Uint64s(key, label, len(perm))
+	for i := len(perm) - 1; i > 0; i-- {
+		j := values[i] % uint64(i+1)
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+	return &perm
+}
+
+// Gear returns the Gear table derived from key. Tables are computed once
+// per key and shared by all chunkers using that key.
+func Gear(key []byte) *[256]uint64 {
+	if table, exists := gearTables.Load(string(key)); exists {
+		return table.(*[256]uint64)
+	}
+
+	var table [256]uint64
+	copy(table[:], Uint64s(key, "gear", len(table)))
+
+	actual, _ := gearTables.LoadOrStore(string(key), &table)
+	return actual.(*[256]uint64)
+}
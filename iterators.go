@@ -0,0 +1,70 @@
+package chunkers
+
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+import (
+	"hash"
+	"io"
+	"iter"
+)
+
+// Chunk is a chunk yielded by All, Offset is relative to the first chunk
+// yielded and Data is only valid until the next iteration.
+type Chunk struct {
+	Offset uint64
+	Data   []byte
+}
+
+// All returns an iterator over the remaining chunks of chunker. Iteration
+// stops after the last chunk, or after yielding an error.
+func (chunker *Chunker) All() iter.Seq2[Chunk, error] {
+	return func(yield func(Chunk, error) bool) {
+		offset := uint64(0)
+		for {
+			data, err := chunker.Next()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(Chunk{}, err)
+				return
+			}
+
+			if !yield(Chunk{Offset: offset, Data: data}, nil) {
+				return
+			}
+			offset += uint64(len(data))
+		}
+	}
+}
+
+// AllRecords returns an iterator over the records of the remaining chunks
+// of chunker, as produced by Records.
+func (chunker *Chunker) AllRecords(hashFactory func() hash.Hash) iter.Seq2[ChunkRecord, error] {
+	return func(yield func(ChunkRecord, error) bool) {
+		records := chunker.Records(hashFactory)
+		for {
+			record, err := records.Next()
+			if err == io.EOF {
+				return
+			}
+			if !yield(record, err) || err != nil {
+				return
+			}
+		}
+	}
+}
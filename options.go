@@ -0,0 +1,93 @@
+package chunkers
+
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Option configures the options of a chunker, the options of NewChunker
+// start from the defaults of the algorithm and are applied in order.
+//
+// A *ChunkerOpts is itself an Option which replaces all options, a nil
+// *ChunkerOpts keeps them unchanged.
+type Option interface {
+	apply(*ChunkerOpts)
+}
+
+// OptionFunc adapts a function to an Option, it allows algorithms to provide
+// their own options.
+type OptionFunc func(*ChunkerOpts)
+
+func (f OptionFunc) apply(opts *ChunkerOpts) {
+	f(opts)
+}
+
+func (o *ChunkerOpts) apply(opts *ChunkerOpts) {
+	if o != nil {
+		*opts = *o
+	}
+}
+
+func WithMinSize(size int) Option {
+	return OptionFunc(func(opts *ChunkerOpts) { opts.MinSize = size })
+}
+
+func WithMaxSize(size int) Option {
+	return OptionFunc(func(opts *ChunkerOpts) { opts.MaxSize = size })
+}
+
+func WithNormalSize(size int) Option {
+	return OptionFunc(func(opts *ChunkerOpts) { opts.NormalSize = size })
+}
+
+func WithKey(key []byte) Option {
+	return OptionFunc(func(opts *ChunkerOpts) { opts.Key = key })
+}
+
+// WithExtra sets an option of an algorithm that has no ChunkerOpts field.
+func WithExtra(name string, value any) Option {
+	return OptionFunc(func(opts *ChunkerOpts) {
+		extra := make(map[string]any, len(opts.Extra)+1)
+		for k, v := range opts.Extra {
+			extra[k] = v
+		}
+		extra[name] = value
+		opts.Extra = extra
+	})
+}
+
+// NewOptions returns the default options of algorithm with options applied,
+// for use where a *ChunkerOpts is expected such as with a Pool.
+func NewOptions(algorithm string, options ...Option) (*ChunkerOpts, error) {
+	return DefaultRegistry.NewOptions(algorithm, options...)
+}
+
+// NewOptions is like the package-level NewOptions for the algorithms of r.
+func (r *Registry) NewOptions(algorithm string, options ...Option) (*ChunkerOpts, error) {
+	implementationAllocator, err := r.lookup(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return applyOptions(implementationAllocator(), options), nil
+}
+
+func applyOptions(implementation ChunkerImplementation, options []Option) *ChunkerOpts {
+	opts := implementation.DefaultOptions()
+	for _, option := range options {
+		if option != nil {
+			option.apply(opts)
+		}
+	}
+	return opts
+}
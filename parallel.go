@@ -0,0 +1,235 @@
+package chunkers
+
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+import (
+	"io"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// segmentSize is the number of bytes chunked by a worker at a time, it is
+// independent of MaxSize so that the memory held by a batch only grows by
+// MaxSize per worker.
+const segmentSize = 4 << 20
+
+// ParallelChunker chunks an io.ReaderAt by splitting it into segments that
+// are chunked concurrently, each from its own start offset. Since a cutpoint
+// only depends on the data following the previous cutpoint, the segments are
+// then stitched back together by resuming the sequential chain at the seams
+// until it meets a cutpoint found by the worker, so that the chunks are
+// identical to those produced by Chunker.Next over the same input.
+type ParallelChunker struct {
+	rd             io.ReaderAt
+	size           int64
+	options        *ChunkerOpts
+	implementation ChunkerImplementation
+	workers        []ChunkerImplementation
+
+	segments []*segment
+	dispatch int64
+	offset   int64
+}
+
+// segment holds the data of a segment, extended by MaxSize bytes so that the
+// chunk crossing its end can be computed, and the cutpoints found when
+// chunking it from its start offset.
+type segment struct {
+	start     int64
+	end       int64
+	data      []byte
+	cutpoints []int64
+	next      int
+}
+
+// NewParallelChunker returns a chunker for the size bytes of reader chunked by
+// workers goroutines, zero selecting GOMAXPROCS. The options are handled as by
+// NewChunker.
+func NewParallelChunker(algorithm string, reader io.ReaderAt, size int64, workers int, options ...Option) (*ParallelChunker, error) {
+	return DefaultRegistry.NewParallelChunker(algorithm, reader, size, workers, options...)
+}
+
+// NewParallelChunker is like the package-level NewParallelChunker for the
+// algorithms of r.
+func (r *Registry) NewParallelChunker(algorithm string, reader io.ReaderAt, size int64, workers int, options ...Option) (*ParallelChunker, error) {
+	implementationAllocator, err := r.lookup(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	implementation := implementationAllocator()
+	opts := applyOptions(implementation, options)
+	if err := implementation.Validate(opts); err != nil {
+		return nil, err
+	}
+	resolveOptions(implementation, opts)
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	chunker := &ParallelChunker{}
+	chunker.rd = reader
+	chunker.size = size
+	chunker.options = opts
+	chunker.implementation = implementation
+	chunker.workers = make([]ChunkerImplementation, workers)
+	for i := range chunker.workers {
+		chunker.workers[i] = implementationAllocator()
+	}
+
+	return chunker, nil
+}
+
+func (chunker *ParallelChunker) MinSize() int {
+	return chunker.options.MinSize
+}
+
+func (chunker *ParallelChunker) MaxSize() int {
+	return chunker.options.MaxSize
+}
+
+func (chunker *ParallelChunker) NormalSize() int {
+	return chunker.options.NormalSize
+}
+
+func (chunker *ParallelChunker) NormalizationLevel() int {
+	return chunker.options.NormalizationLevel
+}
+
+// cutpoint returns the cutpoint following offset, which must be within the
+// data of seg.
+func (chunker *ParallelChunker) cutpoint(implementation ChunkerImplementation, seg *segment, offset int64) int64 {
+	begin := offset - seg.start
+	end := begin + int64(chunker.options.MaxSize)
+	if end > int64(len(seg.data)) {
+		end = int64(len(seg.data))
+	}
+	data := seg.data[begin:end]
+	return offset + int64(implementation.Algorithm(chunker.options, data, len(data)))
+}
+
+func (chunker *ParallelChunker) load(implementation ChunkerImplementation, seg *segment) error {
+	dataEnd := seg.end + int64(chunker.options.MaxSize)
+	if dataEnd > chunker.size {
+		dataEnd = chunker.size
+	}
+
+	seg.data = make([]byte, dataEnd-seg.start)
+	n, err := chunker.rd.ReadAt(seg.data, seg.start)
+	if n != len(seg.data) {
+		if err == nil || err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	offset := seg.start
+	seg.cutpoints = append(seg.cutpoints, offset)
+	for offset < seg.end {
+		offset = chunker.cutpoint(implementation, seg, offset)
+		seg.cutpoints = append(seg.cutpoints, offset)
+	}
+	return nil
+}
+
+// fill chunks the next batch of segments, one per worker.
+func (chunker *ParallelChunker) fill() error {
+	chunker.segments = chunker.segments[:0]
+	for len(chunker.segments) < len(chunker.workers) && chunker.dispatch < chunker.size {
+		end := chunker.dispatch + segmentSize
+		if end > chunker.size {
+			end = chunker.size
+		}
+		chunker.segments = append(chunker.segments, &segment{start: chunker.dispatch, end: end})
+		chunker.dispatch = end
+	}
+
+	errs := make([]error, len(chunker.segments))
+	var wg sync.WaitGroup
+	for i, seg := range chunker.segments {
+		wg.Add(1)
+		go func(i int, seg *segment) {
+			defer wg.Done()
+			errs[i] = chunker.load(chunker.workers[i], seg)
+		}(i, seg)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Next returns the next chunk following the same contract as Chunker.Next.
+func (chunker *ParallelChunker) Next() ([]byte, error) {
+	if chunker.offset >= chunker.size {
+		return nil, io.EOF
+	}
+
+	for len(chunker.segments) == 0 || chunker.offset >= chunker.segments[0].end {
+		if len(chunker.segments) > 1 {
+			chunker.segments = chunker.segments[1:]
+			continue
+		}
+		if err := chunker.fill(); err != nil {
+			return nil, err
+		}
+	}
+	seg := chunker.segments[0]
+
+	// resynchronise with the cutpoints found by the worker if the sequential
+	// chain meets them, chunk from the current offset otherwise.
+	seg.next += sort.Search(len(seg.cutpoints)-seg.next, func(i int) bool {
+		return seg.cutpoints[seg.next+i] >= chunker.offset
+	})
+	var cutpoint int64
+	if seg.cutpoints[seg.next] == chunker.offset {
+		cutpoint = seg.cutpoints[seg.next+1]
+	} else {
+		cutpoint = chunker.cutpoint(chunker.implementation, seg, chunker.offset)
+	}
+
+	chunk := seg.data[chunker.offset-seg.start : cutpoint-seg.start]
+	chunker.offset = cutpoint
+
+	return chunk, nil
+}
+
+// Split calls callback for each chunk following the same contract as
+// Chunker.Split.
+func (chunker *ParallelChunker) Split(callback func(offset, length uint, chunk []byte) error) error {
+	offset := uint(0)
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = callback(offset, uint(len(chunk)), chunk); err != nil {
+			return err
+		}
+		offset += uint(len(chunk))
+	}
+}
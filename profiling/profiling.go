@@ -39,23 +39,19 @@ func main() {
 	if err != nil {
 		log.Fatalf(`chunker error: %s`, err)
 	}
-	for err := error(nil); err == nil; {
+	for {
 		chunk, err := chunker.Next()
-		if err != nil && err != io.EOF {
-			log.Fatalf(`chunker error: %s`, err)
+		if err == io.EOF {
+			break
 		}
-		if len(chunk) < int(chunker.MinSize()) && err != io.EOF {
-			log.Fatalf(`chunker return a chunk below MinSize before last chunk: %s`, err)
+		if err != nil {
+			log.Fatalf(`chunker error: %s`, err)
 		}
 		if len(chunk) > int(chunker.MaxSize()) {
 			log.Fatalf(`chunker return a chunk above MaxSize`)
 		}
 
 		fmt.Println("chunk size:", len(chunk))
-
-		if err == io.EOF {
-			break
-		}
 	}
 
 }
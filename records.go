@@ -0,0 +1,163 @@
+package chunkers
+
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+import (
+	"hash"
+	"io"
+)
+
+// ChunkRecord describes a chunk by its position in the input and the digest
+// of its content.
+type ChunkRecord struct {
+	Offset uint64
+	Length uint32
+	Digest []byte
+}
+
+// Records produces a ChunkRecord for each chunk of a Chunker, hashing chunks
+// as they are found.
+type Records struct {
+	chunker *Chunker
+	hasher  hash.Hash
+	offset  uint64
+	eof     bool
+
+	chunks chan pipelinedChunk
+	free   chan []byte
+	done   chan struct{}
+}
+
+type pipelinedChunk struct {
+	data []byte
+	err  error
+}
+
+// Records returns the records of the remaining chunks of chunker, each chunk
+// is hashed with a hash.Hash obtained from hashFactory.
+func (chunker *Chunker) Records(hashFactory func() hash.Hash) *Records {
+	return &Records{
+		chunker: chunker,
+		hasher:  hashFactory(),
+	}
+}
+
+// PipelinedRecords is like Records but chunks on a separate goroutine so
+// that chunking and hashing overlap, keeping up to depth chunks in flight.
+// Close must be called if the records aren't consumed until io.EOF.
+func (chunker *Chunker) PipelinedRecords(hashFactory func() hash.Hash, depth int) *Records {
+	if depth < 1 {
+		depth = 1
+	}
+
+	records := chunker.Records(hashFactory)
+	records.chunks = make(chan pipelinedChunk, depth)
+	records.free = make(chan []byte, depth+1)
+	records.done = make(chan struct{})
+	go records.produce()
+	return records
+}
+
+func (records *Records) produce() {
+	defer close(records.chunks)
+	for {
+		chunk, err := records.chunker.Next()
+
+		var buffer []byte
+		select {
+		case buffer = <-records.free:
+		default:
+		}
+		buffer = append(buffer[:0], chunk...)
+
+		select {
+		case records.chunks <- pipelinedChunk{data: buffer, err: err}:
+		case <-records.done:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (records *Records) next() ([]byte, error) {
+	if records.chunks == nil {
+		return records.chunker.Next()
+	}
+
+	chunk, ok := <-records.chunks
+	if !ok {
+		return nil, io.EOF
+	}
+	return chunk.data, chunk.err
+}
+
+func (records *Records) release(chunk []byte) {
+	if records.free == nil {
+		return
+	}
+	select {
+	case records.free <- chunk:
+	default:
+	}
+}
+
+// Next returns the record of the next chunk, or io.EOF once all chunks have
+// been returned.
+func (records *Records) Next() (ChunkRecord, error) {
+	if records.eof {
+		return ChunkRecord{}, io.EOF
+	}
+
+	chunk, err := records.next()
+	if err != nil {
+		if err == io.EOF {
+			records.eof = true
+		}
+		return ChunkRecord{}, err
+	}
+
+	records.hasher.Reset()
+	records.hasher.Write(chunk)
+	record := ChunkRecord{
+		Offset: records.offset,
+		Length: uint32(len(chunk)),
+		Digest: records.hasher.Sum(nil),
+	}
+	records.offset += uint64(len(chunk))
+	records.release(chunk)
+
+	return record, nil
+}
+
+// Close stops the chunking goroutine of pipelined records and waits for it to
+// exit, the chunker can be reused once Close returns.
+func (records *Records) Close() error {
+	records.eof = true
+	if records.done != nil {
+		select {
+		case <-records.done:
+		default:
+			close(records.done)
+		}
+		// the producer closes chunks when it exits
+		for range records.chunks {
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package chunkers
+
+/*
+ * Copyright (c) 2024 Gilles Chehade <gilles@poolp.org>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrAlreadyRegistered = errors.New("algorithm already registered")
+	ErrUnknownAlgorithm  = errors.New("unknown algorithm")
+)
+
+// Registry maps algorithm names to their implementations, it is safe for
+// concurrent use and its zero value is an empty registry. Algorithms register
+// themselves in DefaultRegistry, which is used by the package-level functions.
+type Registry struct {
+	mu         sync.RWMutex
+	algorithms map[string]func() ChunkerImplementation
+}
+
+// DefaultRegistry holds the algorithms registered with Register.
+var DefaultRegistry = &Registry{}
+
+// Register adds the algorithm name, it fails with ErrAlreadyRegistered if the
+// name is taken.
+func (r *Registry) Register(name string, implementation func() ChunkerImplementation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.algorithms[name]; exists {
+		return ErrAlreadyRegistered
+	}
+	if r.algorithms == nil {
+		r.algorithms = make(map[string]func() ChunkerImplementation)
+	}
+	r.algorithms[name] = implementation
+	return nil
+}
+
+// MustRegister is like Register but panics if the name is taken.
+func (r *Registry) MustRegister(name string, implementation func() ChunkerImplementation) {
+	if err := r.Register(name, implementation); err != nil {
+		panic(name + ": " + err.Error())
+	}
+}
+
+// Override registers the algorithm name, replacing any algorithm previously
+// registered under that name.
+func (r *Registry) Override(name string, implementation func() ChunkerImplementation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.algorithms == nil {
+		r.algorithms = make(map[string]func() ChunkerImplementation)
+	}
+	r.algorithms[name] = implementation
+}
+
+// Unregister removes the algorithm name, it fails with ErrUnknownAlgorithm if
+// no algorithm is registered under that name.
+func (r *Registry) Unregister(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.algorithms[name]; !exists {
+		return ErrUnknownAlgorithm
+	}
+	delete(r.algorithms, name)
+	return nil
+}
+
+// Algorithms returns the names of the registered algorithms in lexical
+// order.
+func (r *Registry) Algorithms() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.algorithms))
+	for name := range r.algorithms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Registry) lookup(name string) (func() ChunkerImplementation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	implementationAllocator, exists := r.algorithms[name]
+	if !exists {
+		return nil, ErrUnknownAlgorithm
+	}
+	return implementationAllocator, nil
+}
+
+// Register registers the algorithm name in DefaultRegistry, it fails with
+// ErrAlreadyRegistered if the name is taken.
+func Register(name string, implementation func() ChunkerImplementation) error {
+	return DefaultRegistry.Register(name, implementation)
+}
+
+// MustRegister registers the algorithm name in DefaultRegistry and panics if
+// the name is taken.
+func MustRegister(name string, implementation func() ChunkerImplementation) {
+	DefaultRegistry.MustRegister(name, implementation)
+}
+
+// Algorithms returns the names of the algorithms of DefaultRegistry in
+// lexical order.
+func Algorithms() []string {
+	return DefaultRegistry.Algorithms()
+}
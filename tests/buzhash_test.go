@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func buzhashOptions(seed uint32) *chunkers.ChunkerOpts {
+	return &chunkers.ChunkerOpts{
+		MinSize:    2 << 10,
+		MaxSize:    64 << 10,
+		NormalSize: 8 << 10,
+		WindowSize: 255,
+		Seed:       seed,
+	}
+}
+
+func Test_Buzhash_Seed(t *testing.T) {
+	data := rb[:16<<20]
+
+	if !equalBoundaries(boundaries(t, "buzhash", data, buzhashOptions(1)), boundaries(t, "buzhash", data, buzhashOptions(1))) {
+		t.Fatalf(`identical seeds produced different boundaries`)
+	}
+	if equalBoundaries(boundaries(t, "buzhash", data, buzhashOptions(1)), boundaries(t, "buzhash", data, buzhashOptions(2))) {
+		t.Fatalf(`different seeds produced identical boundaries`)
+	}
+}
+
+// Test_Buzhash_Window checks that a cut only depends on the window before it:
+// moving that window right after MinSize bytes makes it end the first chunk.
+func Test_Buzhash_Window(t *testing.T) {
+	data := rb[:16<<20]
+	opts := buzhashOptions(0)
+
+	cuts := boundaries(t, "buzhash", data, opts)
+	filler := make([]byte, opts.MinSize+opts.MaxSize)
+	rand.New(rand.NewSource(1)).Read(filler)
+
+	offset := 0
+	for _, cut := range cuts[:len(cuts)-1] {
+		length := cut - offset
+		if length < opts.MinSize+opts.WindowSize || length > opts.MaxSize {
+			t.Fatalf(`chunk at %d has length %d`, offset, length)
+		}
+		offset = cut
+		if length == opts.MaxSize {
+			continue
+		}
+
+		input := append(append([]byte{}, filler[:opts.MinSize]...), data[cut-opts.WindowSize:cut]...)
+		input = append(input, filler[opts.MinSize:]...)
+		chunker, err := chunkers.NewChunker("buzhash", bytes.NewReader(input), opts)
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		chunk, err := chunker.Next()
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		if len(chunk) != opts.MinSize+opts.WindowSize {
+			t.Fatalf(`window ending at %d did not end the chunk`, cut)
+		}
+	}
+}
+
+// borgData returns the inputs of tests/testdata/borg/generate.py, made of
+// SHA-256 blocks of seed and a counter.
+func borgData(seed uint64, size int) []byte {
+	data := make([]byte, 0, size+sha256.Size)
+	block := make([]byte, 16)
+	for counter := uint64(0); len(data) < size; counter++ {
+		binary.LittleEndian.PutUint64(block[0:], seed)
+		binary.LittleEndian.PutUint64(block[8:], counter)
+		sum := sha256.Sum256(block)
+		data = append(data, sum[:]...)
+	}
+	return data[:size]
+}
+
+// Test_Buzhash_Borg compares boundaries with those produced by borg for the
+// same chunker_params and seed.
+func Test_Buzhash_Borg(t *testing.T) {
+	vectors, err := os.ReadFile(filepath.Join("testdata", "borg", "buzhash.txt"))
+	if os.IsNotExist(err) {
+		t.Skip(`no vectors generated by borg, see testdata/borg/generate.py`)
+	}
+	if err != nil {
+		t.Fatalf(`vectors error: %s`, err)
+	}
+
+	var opts *chunkers.ChunkerOpts
+	var data, expected []byte
+	check := func() {
+		if opts == nil {
+			return
+		}
+		got := bytes.Buffer{}
+		offset := 0
+		for _, cut := range boundaries(t, "buzhash", data, opts) {
+			fmt.Fprintf(&got, "%d %d\n", offset, cut-offset)
+			offset = cut
+		}
+		if !bytes.Equal(got.Bytes(), expected) {
+			t.Fatalf(`boundaries differ from borg's with %+v`, *opts)
+		}
+	}
+
+	for _, line := range strings.SplitAfter(string(vectors), "\n") {
+		if !strings.HasPrefix(line, "# params=") {
+			if !strings.HasPrefix(line, "#") {
+				expected = append(expected, line...)
+			}
+			continue
+		}
+		check()
+
+		var minExp, maxExp, maskBits, window, size int
+		var seed uint32
+		var dataSeed uint64
+		if _, err := fmt.Sscanf(line, "# params=%d,%d,%d,%d seed=%d data=%d size=%d\n", &minExp, &maxExp, &maskBits, &window, &seed, &dataSeed, &size); err != nil {
+			t.Fatalf(`invalid vectors line %q: %s`, line, err)
+		}
+		opts = &chunkers.ChunkerOpts{
+			MinSize:    1 << minExp,
+			MaxSize:    1 << maxExp,
+			NormalSize: 1 << maskBits,
+			WindowSize: window,
+			Seed:       seed,
+		}
+		data, expected = borgData(dataSeed, size), nil
+	}
+	check()
+}
@@ -10,8 +10,10 @@ import (
 
 	mhofmann "codeberg.org/mhofmann/fastcdc"
 	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/buzhash"
 	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/fastcdc"
 	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/jc"
+	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/rabin"
 	_ "github.com/PlakarLabs/go-cdc-chunkers/chunkers/ultracdc"
 	askeladdk "github.com/askeladdk/fastcdc"
 	jotfs "github.com/jotfs/fastcdc-go"
@@ -52,21 +54,26 @@ func Test_FastCDC_Next(t *testing.T) {
 	if err != nil {
 		t.Fatalf(`chunker error: %s`, err)
 	}
-	for err := error(nil); err == nil; {
+	saw_minsize := false
+	for {
 		chunk, err := chunker.Next()
-		if err != nil && err != io.EOF {
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
 			t.Fatalf(`chunker error: %s`, err)
 		}
-		if len(chunk) < int(chunker.MinSize()) && err != io.EOF {
-			t.Fatalf(`chunker return a chunk below MinSize before last chunk: %s`, err)
+		if len(chunk) < int(chunker.MinSize()) {
+			if saw_minsize != false {
+				t.Fatalf(`chunker return a chunk below MinSize before last chunk: %d < %d`, len(chunk), int(chunker.MinSize()))
+			} else {
+				saw_minsize = true
+			}
 		}
 		if len(chunk) > int(chunker.MaxSize()) {
 			t.Fatalf(`chunker return a chunk above MaxSize`)
 		}
 		hasher.Write(chunk)
-		if err == io.EOF {
-			break
-		}
 	}
 	sum2 := hasher.Sum(nil)
 
@@ -104,7 +111,13 @@ func Test_FastCDC_Copy(t *testing.T) {
 		hasher.Write(p)
 		return len(p), nil
 	})
-	chunker.Copy(w)
+	nbytes, err := chunker.Copy(w)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	if nbytes != int64(len(rb)) {
+		t.Fatalf(`chunker copied %d bytes out of %d`, nbytes, len(rb))
+	}
 	sum2 := hasher.Sum(nil)
 
 	if !bytes.Equal(sum1, sum2) {
@@ -165,21 +178,26 @@ func Test_JC_Next(t *testing.T) {
 	if err != nil {
 		t.Fatalf(`chunker error: %s`, err)
 	}
-	for err := error(nil); err == nil; {
+	saw_minsize := false
+	for {
 		chunk, err := chunker.Next()
-		if err != nil && err != io.EOF {
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
 			t.Fatalf(`chunker error: %s`, err)
 		}
-		if len(chunk) < int(chunker.MinSize()) && err != io.EOF {
-			t.Fatalf(`chunker return a chunk below MinSize before last chunk: %s`, err)
+		if len(chunk) < int(chunker.MinSize()) {
+			if saw_minsize != false {
+				t.Fatalf(`chunker return a chunk below MinSize before last chunk: %d < %d`, len(chunk), int(chunker.MinSize()))
+			} else {
+				saw_minsize = true
+			}
 		}
 		if len(chunk) > int(chunker.MaxSize()) {
 			t.Fatalf(`chunker return a chunk above MaxSize`)
 		}
 		hasher.Write(chunk)
-		if err == io.EOF {
-			break
-		}
 	}
 	sum2 := hasher.Sum(nil)
 
@@ -217,7 +235,13 @@ func Test_JC_Copy(t *testing.T) {
 		hasher.Write(p)
 		return len(p), nil
 	})
-	chunker.Copy(w)
+	nbytes, err := chunker.Copy(w)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	if nbytes != int64(len(rb)) {
+		t.Fatalf(`chunker copied %d bytes out of %d`, nbytes, len(rb))
+	}
 	sum2 := hasher.Sum(nil)
 
 	if !bytes.Equal(sum1, sum2) {
@@ -278,21 +302,26 @@ func Test_UltraCDC(t *testing.T) {
 	if err != nil {
 		t.Fatalf(`chunker error: %s`, err)
 	}
-	for err := error(nil); err == nil; {
+	saw_minsize := false
+	for {
 		chunk, err := chunker.Next()
-		if err != nil && err != io.EOF {
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
 			t.Fatalf(`chunker error: %s`, err)
 		}
-		if len(chunk) < int(chunker.MinSize()) && err != io.EOF {
-			t.Fatalf(`chunker return a chunk below MinSize before last chunk: %s`, err)
+		if len(chunk) < int(chunker.MinSize()) {
+			if saw_minsize != false {
+				t.Fatalf(`chunker return a chunk below MinSize before last chunk: %d < %d`, len(chunk), int(chunker.MinSize()))
+			} else {
+				saw_minsize = true
+			}
 		}
 		if len(chunk) > int(chunker.MaxSize()) {
 			t.Fatalf(`chunker return a chunk above MaxSize`)
 		}
 		hasher.Write(chunk)
-		if err == io.EOF {
-			break
-		}
 	}
 	sum2 := hasher.Sum(nil)
 
@@ -330,7 +359,13 @@ func Test_UltraCDC_Copy(t *testing.T) {
 		hasher.Write(p)
 		return len(p), nil
 	})
-	chunker.Copy(w)
+	nbytes, err := chunker.Copy(w)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	if nbytes != int64(len(rb)) {
+		t.Fatalf(`chunker copied %d bytes out of %d`, nbytes, len(rb))
+	}
 	sum2 := hasher.Sum(nil)
 
 	if !bytes.Equal(sum1, sum2) {
@@ -378,6 +413,53 @@ func Test_UltraCDC_Split(t *testing.T) {
 	}
 }
 
+func Test_Reset(t *testing.T) {
+	chunker, err := chunkers.NewChunker("fastcdc", bytes.NewReader(rb[:1<<20]), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	// leave the chunker in the middle of its input
+	if _, err := chunker.Next(); err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	data := rb[1<<20 : 9<<20]
+	chunker.Reset(bytes.NewReader(data))
+
+	hasher := sha256.New()
+	if _, err := chunker.Copy(hasher); err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	sum := sha256.Sum256(data)
+	if !bytes.Equal(sum[:], hasher.Sum(nil)) {
+		t.Fatalf(`chunker produces incorrect output after Reset`)
+	}
+}
+
+func Test_Pool(t *testing.T) {
+	var pool chunkers.Pool
+
+	for _, algorithm := range algorithms {
+		for i := 0; i < 4; i++ {
+			data := rb[i<<20 : (i+1)<<20]
+			chunker, err := pool.Get(algorithm, bytes.NewReader(data), nil)
+			if err != nil {
+				t.Fatalf(`chunker error: %s`, err)
+			}
+
+			hasher := sha256.New()
+			if _, err := chunker.Copy(hasher); err != nil {
+				t.Fatalf(`chunker error: %s`, err)
+			}
+			sum := sha256.Sum256(data)
+			if !bytes.Equal(sum[:], hasher.Sum(nil)) {
+				t.Fatalf(`%s: chunker produces incorrect output after reuse`, algorithm)
+			}
+			pool.Put(chunker)
+		}
+	}
+}
+
 func Benchmark_Restic_Rabin_Next(b *testing.B) {
 	r := bytes.NewReader(rb)
 	b.SetBytes(int64(r.Len()))
@@ -544,7 +626,7 @@ func Benchmark_PlakarLabs_FastCDC_Split(b *testing.B) {
 			b.Fatalf(`chunker error: %s`, err)
 		}
 		err = chunker.Split(w)
-		if err != nil && err != io.EOF {
+		if err != nil {
 			b.Fatalf(`chunker error: %s`, err)
 		}
 		r.Reset(rb)
@@ -629,7 +711,7 @@ func Benchmark_PlakarLabs_UltraCDC_Split(b *testing.B) {
 			b.Fatalf(`chunker error: %s`, err)
 		}
 		err = chunker.Split(w)
-		if err != nil && err != io.EOF {
+		if err != nil {
 			b.Fatalf(`chunker error: %s`, err)
 		}
 		r.Reset(rb)
@@ -714,7 +796,7 @@ func Benchmark_PlakarLabs_JC_Split(b *testing.B) {
 			b.Fatalf(`chunker error: %s`, err)
 		}
 		err = chunker.Split(w)
-		if err != nil && err != io.EOF {
+		if err != nil {
 			b.Fatalf(`chunker error: %s`, err)
 		}
 		r.Reset(rb)
@@ -747,3 +829,93 @@ func Benchmark_PlakarLabs_JC_Next(b *testing.B) {
 	}
 	b.ReportMetric(float64(nchunks)/float64(b.N), "chunks")
 }
+
+const (
+	smallFileSize = 16 << 10
+	smallFiles    = 1024
+)
+
+func Benchmark_PlakarLabs_FastCDC_SmallFiles_NewChunker(b *testing.B) {
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+
+	w := writerFunc(func(p []byte) (int, error) {
+		return len(p), nil
+	})
+
+	r := bytes.NewReader(nil)
+	b.SetBytes(smallFileSize * smallFiles)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < smallFiles; j++ {
+			r.Reset(rb[j*smallFileSize : (j+1)*smallFileSize])
+			chunker, err := chunkers.NewChunker("fastcdc", r, opts)
+			if err != nil {
+				b.Fatalf(`chunker error: %s`, err)
+			}
+			chunker.Copy(w)
+		}
+	}
+}
+
+func Benchmark_PlakarLabs_FastCDC_SmallFiles_Reset(b *testing.B) {
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+
+	w := writerFunc(func(p []byte) (int, error) {
+		return len(p), nil
+	})
+
+	r := bytes.NewReader(nil)
+	chunker, err := chunkers.NewChunker("fastcdc", r, opts)
+	if err != nil {
+		b.Fatalf(`chunker error: %s`, err)
+	}
+
+	b.SetBytes(smallFileSize * smallFiles)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < smallFiles; j++ {
+			r.Reset(rb[j*smallFileSize : (j+1)*smallFileSize])
+			chunker.Reset(r)
+			chunker.Copy(w)
+		}
+	}
+}
+
+func Benchmark_PlakarLabs_FastCDC_SmallFiles_Pool(b *testing.B) {
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+
+	w := writerFunc(func(p []byte) (int, error) {
+		return len(p), nil
+	})
+
+	var pool chunkers.Pool
+	r := bytes.NewReader(nil)
+	b.SetBytes(smallFileSize * smallFiles)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < smallFiles; j++ {
+			r.Reset(rb[j*smallFileSize : (j+1)*smallFileSize])
+			chunker, err := pool.Get("fastcdc", r, opts)
+			if err != nil {
+				b.Fatalf(`chunker error: %s`, err)
+			}
+			chunker.Copy(w)
+			pool.Put(chunker)
+		}
+	}
+}
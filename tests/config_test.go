@@ -0,0 +1,264 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/buzhash"
+)
+
+func configBoundaries(t *testing.T, cfg *chunkers.Config, data []byte, options ...chunkers.Option) []int {
+	chunker, err := chunkers.NewChunkerFromConfig(cfg, bytes.NewReader(data), options...)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	var cuts []int
+	offset := 0
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		offset += len(chunk)
+		cuts = append(cuts, offset)
+	}
+	return cuts
+}
+
+func Test_Config_Text(t *testing.T) {
+	tests := []struct {
+		text string
+		cfg  chunkers.Config
+	}{
+		{"fastcdc", chunkers.Config{Algorithm: "fastcdc"}},
+		{"fastcdc@v2:min=2k,avg=8k,max=64k,level=2,keyed",
+			chunkers.Config{Algorithm: "fastcdc", Version: "v2", MinSize: 2 << 10, NormalSize: 8 << 10, MaxSize: 64 << 10, NormalizationLevel: 2, Keyed: true}},
+		{"fastcdc:level=0", chunkers.Config{Algorithm: "fastcdc", NormalizationLevel: chunkers.NormalizationNone}},
+		{"rabin:min=512k,avg=1m,max=8m,poly=0x3da3358b4dc173",
+			chunkers.Config{Algorithm: "rabin", MinSize: 512 << 10, NormalSize: 1 << 20, MaxSize: 8 << 20, Polynomial: 0x3DA3358B4DC173}},
+		{"buzhash:min=1000,avg=1g,max=1025,window=48,seed=42",
+			chunkers.Config{Algorithm: "buzhash", MinSize: 1000, NormalSize: 1 << 30, MaxSize: 1025, WindowSize: 48, Seed: 42}},
+	}
+
+	for _, test := range tests {
+		var cfg chunkers.Config
+		if err := cfg.UnmarshalText([]byte(test.text)); err != nil {
+			t.Fatalf(`%s: unmarshal error: %s`, test.text, err)
+		}
+		if cfg != test.cfg {
+			t.Fatalf(`%s: got %+v, expected %+v`, test.text, cfg, test.cfg)
+		}
+		if text, err := cfg.MarshalText(); err != nil || string(text) != test.text {
+			t.Fatalf(`%s: marshaled as %s (%v)`, test.text, text, err)
+		}
+	}
+
+	for _, text := range []string{
+		"", "@v2", "fastcdc:", "fastcdc:min=2k,min=4k", "fastcdc:min=-1", "fastcdc:min=4g",
+		"fastcdc:size=2k", "fastcdc:keyed=true", "fastcdc:seed=4294967296", "fastcdc:poly=x",
+	} {
+		var cfg chunkers.Config
+		if err := cfg.UnmarshalText([]byte(text)); err == nil {
+			t.Fatalf(`%q was accepted as %+v`, text, cfg)
+		}
+	}
+}
+
+func Test_Config_JSON(t *testing.T) {
+	cfg, err := chunkers.NewConfig("fastcdc", nil)
+	if err != nil {
+		t.Fatalf(`config error: %s`, err)
+	}
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf(`marshal error: %s`, err)
+	}
+	expected := `{"algorithm":"fastcdc","version":"v2","min_size":2048,"max_size":65536,"normal_size":8192,"normalization_level":2}`
+	if string(data) != expected {
+		t.Fatalf(`got %s, expected %s`, data, expected)
+	}
+
+	var decoded chunkers.Config
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != *cfg {
+		t.Fatalf(`decoded %+v (%v), expected %+v`, decoded, err, *cfg)
+	}
+
+	var fromText chunkers.Config
+	if err := json.Unmarshal([]byte(`"`+cfg.String()+`"`), &fromText); err != nil || fromText != *cfg {
+		t.Fatalf(`decoded %+v (%v), expected %+v`, fromText, err, *cfg)
+	}
+
+	if err := json.Unmarshal([]byte(`{"min_size":2048}`), &decoded); err == nil {
+		t.Fatalf(`config without algorithm was accepted`)
+	}
+}
+
+// checkConfig checks that the config of a chunker created with opts, once
+// marshaled to text, reopens a chunker with the same options and boundaries.
+func checkConfig(t *testing.T, algorithm string, data []byte, opts *chunkers.ChunkerOpts) {
+	chunker, err := chunkers.NewChunker(algorithm, bytes.NewReader(nil), opts)
+	if err != nil {
+		t.Fatalf(`%s: chunker error: %s`, algorithm, err)
+	}
+	cfg, err := chunker.Config()
+	if err != nil {
+		t.Fatalf(`%s: config error: %s`, algorithm, err)
+	}
+	if cfg.Keyed != (len(opts.Key) != 0) {
+		t.Fatalf(`%s: config keyed is %v`, algorithm, cfg.Keyed)
+	}
+
+	text, _ := cfg.MarshalText()
+	var reopened chunkers.Config
+	if err := reopened.UnmarshalText(text); err != nil {
+		t.Fatalf(`%s: unmarshal error: %s`, algorithm, err)
+	}
+
+	var options []chunkers.Option
+	if len(opts.Key) != 0 {
+		options = append(options, chunkers.WithKey(opts.Key))
+	}
+	again, err := chunkers.NewChunkerFromConfig(&reopened, bytes.NewReader(nil), options...)
+	if err != nil {
+		t.Fatalf(`%s: %s: chunker error: %s`, algorithm, text, err)
+	}
+	if again.MinSize() != chunker.MinSize() || again.NormalSize() != chunker.NormalSize() || again.MaxSize() != chunker.MaxSize() {
+		t.Fatalf(`%s: %s reopens a chunker with sizes %d/%d/%d instead of %d/%d/%d`, algorithm, text,
+			again.MinSize(), again.NormalSize(), again.MaxSize(), chunker.MinSize(), chunker.NormalSize(), chunker.MaxSize())
+	}
+	if again.NormalizationLevel() != chunker.NormalizationLevel() {
+		t.Fatalf(`%s: %s reopens a chunker with level %d instead of %d`, algorithm, text, again.NormalizationLevel(), chunker.NormalizationLevel())
+	}
+	if !equalBoundaries(boundaries(t, algorithm, data, opts), configBoundaries(t, &reopened, data, options...)) {
+		t.Fatalf(`%s: %s produces different boundaries`, algorithm, text)
+	}
+}
+
+func Test_NewChunkerFromConfig(t *testing.T) {
+	data := rb[:8<<20]
+
+	for _, algorithm := range algorithms {
+		for _, key := range []byte{0, 1} {
+			checkConfig(t, algorithm, data, keyedOptions(algorithm, key))
+		}
+	}
+
+	// ultracdc derives a zero NormalSize from MinSize
+	checkConfig(t, "ultracdc", data, &chunkers.ChunkerOpts{MinSize: 4 << 10, MaxSize: 64 << 10})
+}
+
+// Test_Config_RoundTrip checks that options, including options left at zero,
+// survive a config marshaled to text for every algorithm.
+func Test_Config_RoundTrip(t *testing.T) {
+	data := rb[:4<<20]
+	key := bytes.Repeat([]byte{1}, chunkers.KeySize)
+
+	for _, algorithm := range algorithms {
+		defaults, err := chunkers.NewOptions(algorithm)
+		if err != nil {
+			t.Fatalf(`%s: options error: %s`, algorithm, err)
+		}
+
+		sizes := *defaults
+		sizes.NormalizationLevel, sizes.WindowSize, sizes.Polynomial, sizes.Seed = 0, 0, 0, 0
+		variants := []chunkers.ChunkerOpts{*defaults, sizes}
+		for _, set := range []func(*chunkers.ChunkerOpts){
+			func(opts *chunkers.ChunkerOpts) { opts.MinSize = 0 },
+			func(opts *chunkers.ChunkerOpts) { opts.NormalSize = 0 },
+			func(opts *chunkers.ChunkerOpts) { opts.MaxSize = 0 },
+			func(opts *chunkers.ChunkerOpts) { opts.Key = key },
+			func(opts *chunkers.ChunkerOpts) { opts.NormalizationLevel = chunkers.NormalizationNone },
+			func(opts *chunkers.ChunkerOpts) { opts.NormalizationLevel = 3 },
+			func(opts *chunkers.ChunkerOpts) { opts.Polynomial = 0x2482734CACCA49 },
+			func(opts *chunkers.ChunkerOpts) { opts.WindowSize, opts.Seed = 48, 42 },
+		} {
+			opts := sizes
+			set(&opts)
+			variants = append(variants, opts)
+		}
+
+		for _, opts := range variants {
+			if chunkers.ValidateOptions(algorithm, &opts) != nil {
+				continue
+			}
+			checkConfig(t, algorithm, data, &opts)
+		}
+	}
+}
+
+// Test_NewChunkerFromConfig_Defaults checks that options left out of a
+// config select the defaults of the algorithm.
+func Test_NewChunkerFromConfig_Defaults(t *testing.T) {
+	data := rb[:4<<20]
+
+	for _, test := range []struct {
+		text     string
+		expected []chunkers.Option
+	}{
+		{"fastcdc", nil},
+		{"fastcdc:min=2k,avg=8k,max=64k", nil},
+		{"fastcdc:avg=16k", []chunkers.Option{chunkers.WithNormalSize(16 << 10)}},
+		{"jc:min=4k", []chunkers.Option{chunkers.WithMinSize(4 << 10)}},
+		{"rabin", nil},
+		{"buzhash:window=48", []chunkers.Option{buzhash.WithWindowSize(48)}},
+		{"ultracdc", nil},
+	} {
+		var cfg chunkers.Config
+		if err := cfg.UnmarshalText([]byte(test.text)); err != nil {
+			t.Fatalf(`%s: unmarshal error: %s`, test.text, err)
+		}
+		expected, _ := chunkers.NewOptions(cfg.Algorithm, test.expected...)
+		opts, err := cfg.Options()
+		if err != nil {
+			t.Fatalf(`%s: options error: %s`, test.text, err)
+		}
+		if !reflect.DeepEqual(opts, expected) {
+			t.Fatalf(`%s: got options %+v, expected %+v`, test.text, opts, expected)
+		}
+		if !equalBoundaries(boundaries(t, cfg.Algorithm, data, expected), configBoundaries(t, &cfg, data)) {
+			t.Fatalf(`%s: config produces different boundaries`, test.text)
+		}
+	}
+}
+
+func Test_NewChunkerFromConfig_Errors(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, chunkers.KeySize)
+
+	cfg, _ := chunkers.NewConfig("fastcdc", nil)
+	if _, err := chunkers.NewChunkerFromConfig(cfg, bytes.NewReader(nil), chunkers.WithKey(key)); !errors.Is(err, chunkers.ErrKeyMismatch) {
+		t.Fatalf(`got error %v, expected ErrKeyMismatch`, err)
+	}
+
+	cfg.Keyed = true
+	if _, err := chunkers.NewChunkerFromConfig(cfg, bytes.NewReader(nil)); !errors.Is(err, chunkers.ErrKeyMismatch) {
+		t.Fatalf(`got error %v, expected ErrKeyMismatch`, err)
+	}
+
+	cfg.Version = "v1"
+	if _, err := chunkers.NewChunkerFromConfig(cfg, bytes.NewReader(nil), chunkers.WithKey(key)); !errors.Is(err, chunkers.ErrVersionMismatch) {
+		t.Fatalf(`got error %v, expected ErrVersionMismatch`, err)
+	}
+
+	cfg.Algorithm = "unknown"
+	if _, err := chunkers.NewChunkerFromConfig(cfg, bytes.NewReader(nil)); !errors.Is(err, chunkers.ErrUnknownAlgorithm) {
+		t.Fatalf(`got error %v, expected ErrUnknownAlgorithm`, err)
+	}
+
+	if _, err := chunkers.NewConfig("fastcdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 1 << 10, NormalSize: 8 << 10}); err == nil {
+		t.Fatalf(`config with invalid options was created`)
+	}
+	opts, _ := chunkers.NewOptions("fastcdc", chunkers.WithExtra("a", 1))
+	if _, err := chunkers.NewConfig("fastcdc", opts); err == nil {
+		t.Fatalf(`config with extra options was created`)
+	}
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkerstest"
+)
+
+// algorithms lists the registered algorithms, which must all pass the
+// conformance tests.
+var algorithms = chunkers.Algorithms()
+
+func Test_Conformance(t *testing.T) {
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			chunkerstest.Run(t, algorithm)
+		})
+	}
+}
+
+func Test_Conformance_Options(t *testing.T) {
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			chunkerstest.RunWithOptions(t, algorithm, opts)
+		})
+	}
+}
+
+// gear is a minimal content-defined algorithm whose mask is only found in
+// ChunkerOpts.Extra, as third-party algorithms do for their own options.
+type gear struct{}
+
+var gearTable = func() (table [256]uint64) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := range table {
+		table[i] = rnd.Uint64()
+	}
+	return table
+}()
+
+func newGear() chunkers.ChunkerImplementation {
+	return &gear{}
+}
+
+func (c *gear) DefaultOptions() *chunkers.ChunkerOpts {
+	return &chunkers.ChunkerOpts{
+		MinSize:    2 << 10,
+		MaxSize:    64 << 10,
+		NormalSize: 8 << 10,
+		Extra:      map[string]any{"mask": uint64(0x1fff) << 48},
+	}
+}
+
+func (c *gear) Validate(options *chunkers.ChunkerOpts) error {
+	if _, ok := options.Extra["mask"].(uint64); !ok {
+		return errors.New("missing mask")
+	}
+	if options.MinSize < 1 || options.MaxSize <= options.MinSize {
+		return &chunkers.OptionsError{Field: "MaxSize", Value: options.MaxSize, Min: options.MinSize + 1, Max: options.MinSize + 1}
+	}
+	return nil
+}
+
+func (c *gear) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) int {
+	mask := options.Extra["mask"].(uint64)
+	if n <= options.MinSize {
+		return n
+	}
+	n = min(n, options.MaxSize)
+
+	fp := uint64(0)
+	for i := options.MinSize; i < n; i++ {
+		fp = (fp << 1) + gearTable[data[i]]
+		if fp&mask == 0 {
+			return i
+		}
+	}
+	return n
+}
+
+func Test_Conformance_Registry(t *testing.T) {
+	var registry chunkers.Registry
+	registry.MustRegister("gear", newGear)
+
+	chunkerstest.RunRegistry(t, &registry, "gear", nil)
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+// slowReader delays every read, simulating a slow backend.
+type slowReader struct {
+	rd    io.Reader
+	delay time.Duration
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	time.Sleep(r.delay)
+	if len(p) > 4<<10 {
+		p = p[:4<<10]
+	}
+	return r.rd.Read(p)
+}
+
+func Test_SplitContext(t *testing.T) {
+	r := &slowReader{rd: bytes.NewReader(rb[:64<<20]), delay: time.Millisecond}
+	chunker, err := chunkers.NewChunker("fastcdc", r, nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nchunks := 0
+	err = chunker.SplitContext(ctx, func(offset, length uint, chunk []byte) error {
+		nchunks++
+		if nchunks == 2 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf(`expected context.Canceled, got %v`, err)
+	}
+	if nchunks != 2 {
+		t.Fatalf(`chunking went on for %d chunks after cancellation`, nchunks-2)
+	}
+}
+
+func Test_CopyContext(t *testing.T) {
+	r := &slowReader{rd: bytes.NewReader(rb[:64<<20]), delay: time.Millisecond}
+	chunker, err := chunkers.NewChunker("fastcdc", r, nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	nbytes, err := chunker.CopyContext(ctx, io.Discard)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf(`expected context.DeadlineExceeded, got %v`, err)
+	}
+	if nbytes >= 64<<20 {
+		t.Fatalf(`input was fully copied despite the deadline`)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf(`CopyContext took %s to notice the deadline`, elapsed)
+	}
+}
+
+func Test_NextContext(t *testing.T) {
+	chunker, err := chunkers.NewChunker("fastcdc", bytes.NewReader(rb[:1<<20]), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := chunker.NextContext(ctx); err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	cancel()
+	if _, err := chunker.NextContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf(`expected context.Canceled, got %v`, err)
+	}
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func Test_Algorithms(t *testing.T) {
+	names := chunkers.Algorithms()
+	if !slices.IsSorted(names) {
+		t.Fatalf(`algorithms are not sorted: %v`, names)
+	}
+	for _, name := range []string{"buzhash", "fastcdc", "fastcdc-v2020", "jc", "rabin", "ultracdc"} {
+		if !slices.Contains(names, name) {
+			t.Fatalf(`%s is not listed in %v`, name, names)
+		}
+	}
+}
+
+func Test_Describe(t *testing.T) {
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			description, err := chunkers.Describe(algorithm)
+			if err != nil {
+				t.Fatalf(`describe error: %s`, err)
+			}
+			if description.Name != algorithm {
+				t.Fatalf(`got name %s`, description.Name)
+			}
+			if version, _ := chunkers.Version(algorithm); description.Version != version {
+				t.Fatalf(`got version %s, expected %s`, description.Version, version)
+			}
+			if !description.Keyed {
+				t.Fatalf(`bundled algorithms are keyed`)
+			}
+			if err := chunkers.ValidateOptions(algorithm, description.DefaultOptions); err != nil {
+				t.Fatalf(`default options are invalid: %s`, err)
+			}
+
+			fields := map[string]bool{}
+			for _, constraint := range description.Constraints {
+				fields[constraint.Field] = true
+			}
+			for _, field := range []string{"MinSize", "MaxSize", "NormalSize", "Key"} {
+				if !fields[field] {
+					t.Fatalf(`no constraint is documented for %s`, field)
+				}
+			}
+
+			description.DefaultOptions.MinSize = 0
+			if again, _ := chunkers.Describe(algorithm); again.DefaultOptions.MinSize == 0 {
+				t.Fatalf(`default options are shared between descriptions`)
+			}
+		})
+	}
+
+	if _, err := chunkers.Describe("unknown"); err == nil {
+		t.Fatalf(`unknown algorithm was described`)
+	}
+}
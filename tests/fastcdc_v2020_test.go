@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func Test_FastCDC2020(t *testing.T) {
+	data := rb[:64<<20]
+
+	tests := []*chunkers.ChunkerOpts{
+		nil,
+		{MinSize: minSize, NormalSize: avgSize, MaxSize: maxSize},
+		{MinSize: 2<<10 + 1, NormalSize: 8<<10 + 2, MaxSize: 64<<10 + 3},
+		keyedOptions("fastcdc", 'A'),
+	}
+
+	for _, opts := range tests {
+		v2016 := boundaries(t, "fastcdc", data, opts)
+		v2020 := boundaries(t, "fastcdc-v2020", data, opts)
+		if !equalBoundaries(v2016, v2020) {
+			t.Fatalf(`fastcdc-v2020 boundaries differ from fastcdc`)
+		}
+	}
+}
+
+func Test_FastCDC2020_Distribution(t *testing.T) {
+	data := rb[:64<<20]
+
+	distribution := func(cuts []int) (mean, stddev float64) {
+		offset := 0
+		for _, cut := range cuts {
+			mean += float64(cut - offset)
+			offset = cut
+		}
+		mean /= float64(len(cuts))
+
+		offset = 0
+		for _, cut := range cuts {
+			stddev += math.Pow(float64(cut-offset)-mean, 2)
+			offset = cut
+		}
+		return mean, math.Sqrt(stddev / float64(len(cuts)))
+	}
+
+	mean2016, stddev2016 := distribution(boundaries(t, "fastcdc", data, nil))
+	mean2020, stddev2020 := distribution(boundaries(t, "fastcdc-v2020", data, nil))
+	if math.Abs(mean2020-mean2016) > mean2016/100 || math.Abs(stddev2020-stddev2016) > stddev2016/100 {
+		t.Fatalf(`fastcdc-v2020 chunk sizes are %.0f±%.0f, fastcdc's are %.0f±%.0f`, mean2020, stddev2020, mean2016, stddev2016)
+	}
+}
+
+func Benchmark_PlakarLabs_FastCDC2020_Next(b *testing.B) {
+	r := bytes.NewReader(rb)
+	b.SetBytes(int64(r.Len()))
+	b.ResetTimer()
+	nchunks := 0
+
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+
+	for i := 0; i < b.N; i++ {
+		chunker, err := chunkers.NewChunker("fastcdc-v2020", r, opts)
+		if err != nil {
+			b.Fatalf(`chunker error: %s`, err)
+		}
+		for err := error(nil); err == nil; {
+			_, err = chunker.Next()
+			nchunks++
+		}
+		r.Reset(rb)
+	}
+	b.ReportMetric(float64(nchunks)/float64(b.N), "chunks")
+}
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+// FuzzChunker feeds arbitrary data and options to every algorithm, checking
+// that chunks stay within bounds and reassemble to the input.
+func FuzzChunker(f *testing.F) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, size := range []int{0, 1, 63, 64, 65, 255, 256, 257, 1023, 1024, 1025, 4096 + 64 + 1} {
+		random := make([]byte, size)
+		rnd.Read(random)
+		for i := range algorithms {
+			f.Add(uint8(i), random, uint16(64), uint16(256), uint16(1024), uint16(63), uint8(2))
+			f.Add(uint8(i), make([]byte, size), uint16(64), uint16(256), uint16(1024), uint16(0), uint8(0))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, algorithm uint8, data []byte, minSize, normalSize, maxSize, windowSize uint16, level uint8) {
+		if int(algorithm) >= len(algorithms) {
+			t.Skip()
+		}
+		name := algorithms[algorithm]
+		opts := &chunkers.ChunkerOpts{
+			MinSize:            int(minSize),
+			NormalSize:         int(normalSize),
+			MaxSize:            int(maxSize),
+			WindowSize:         int(windowSize),
+			NormalizationLevel: int(level),
+		}
+		if chunkers.ValidateOptions(name, opts) != nil {
+			t.Skip()
+		}
+
+		chunker, err := chunkers.NewChunker(name, bytes.NewReader(data), opts)
+		if err != nil {
+			t.Fatalf(`%s: chunker error: %s`, name, err)
+		}
+
+		var reassembled []byte
+		for {
+			chunk, err := chunker.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf(`%s: chunker error: %s`, name, err)
+			}
+			if len(chunk) == 0 || len(chunk) > opts.MaxSize {
+				t.Fatalf(`%s: chunk of %d bytes is out of bounds`, name, len(chunk))
+			}
+			if len(chunk) < opts.MinSize && len(reassembled)+len(chunk) != len(data) {
+				t.Fatalf(`%s: chunk of %d bytes is smaller than MinSize and not the last one`, name, len(chunk))
+			}
+			reassembled = append(reassembled, chunk...)
+		}
+		if !bytes.Equal(reassembled, data) {
+			t.Fatalf(`%s: chunks do not reassemble to the input`, name)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+var update = flag.Bool("update", false, "regenerate the golden boundary files")
+
+type goldenCase struct {
+	name string
+	seed int64
+	size int
+	opts *chunkers.ChunkerOpts
+}
+
+func goldenCases() []goldenCase {
+	key := make([]byte, chunkers.KeySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	custom := &chunkers.ChunkerOpts{MinSize: 16 << 10, NormalSize: 32 << 10, MaxSize: 128 << 10}
+	keyed := &chunkers.ChunkerOpts{MinSize: 2 << 10, NormalSize: 8 << 10, MaxSize: 64 << 10, Key: key}
+
+	return []goldenCase{
+		{"default", 1, 4 << 20, nil},
+		{"default", 2, (1 << 20) + 4321, nil},
+		{"custom", 1, 4 << 20, custom},
+		{"keyed", 1, 4 << 20, keyed},
+	}
+}
+
+// golden renders the boundaries of algorithm for all golden cases, prefixed by
+// the version of the algorithm.
+func golden(t *testing.T, algorithm string) []byte {
+	version, err := chunkers.Version(algorithm)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s %s\n", algorithm, version)
+	for _, c := range goldenCases() {
+		data, _ := io.ReadAll(io.LimitReader(rand.New(rand.NewSource(c.seed)), int64(c.size)))
+		fmt.Fprintf(&buf, "# %s seed=%d size=%d\n", c.name, c.seed, c.size)
+
+		offset := 0
+		for _, cut := range boundaries(t, algorithm, data, c.opts) {
+			fmt.Fprintf(&buf, "%d %d\n", offset, cut-offset)
+			offset = cut
+		}
+	}
+	return buf.Bytes()
+}
+
+// unversioned lists the algorithms whose boundaries aren't frozen yet: buzhash
+// until its base table is borg's.
+var unversioned = map[string]bool{"buzhash": true}
+
+func Test_Golden(t *testing.T) {
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			if unversioned[algorithm] {
+				t.Skip(`boundaries are not frozen`)
+			}
+			path := filepath.Join("testdata", "golden", algorithm+".txt")
+			actual := golden(t, algorithm)
+
+			if *update {
+				if err := os.WriteFile(path, actual, 0644); err != nil {
+					t.Fatalf(`could not update golden file: %s`, err)
+				}
+				return
+			}
+
+			expected, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf(`could not read golden file: %s`, err)
+			}
+			if bytes.Equal(expected, actual) {
+				return
+			}
+
+			expectedLines := strings.Split(string(expected), "\n")
+			actualLines := strings.Split(string(actual), "\n")
+			if expectedLines[0] == actualLines[0] {
+				t.Errorf(`%s boundaries changed without a version bump`, algorithm)
+			}
+			for i := range expectedLines {
+				if i >= len(actualLines) || expectedLines[i] != actualLines[i] {
+					actualLine := ""
+					if i < len(actualLines) {
+						actualLine = actualLines[i]
+					}
+					t.Fatalf(`%s differs from %s at line %d: got %q, expected %q (run go test -run Test_Golden -update once the change is intended)`, algorithm, path, i+1, actualLine, expectedLines[i])
+				}
+			}
+			t.Fatalf(`%s differs from %s: got %d lines, expected %d`, algorithm, path, len(actualLines), len(expectedLines))
+		})
+	}
+}
+
+func Test_Version(t *testing.T) {
+	for _, algorithm := range algorithms {
+		version, err := chunkers.Version(algorithm)
+		if err != nil {
+			t.Fatalf(`%s: version error: %s`, algorithm, err)
+		}
+		if unversioned[algorithm] != (version == "") {
+			t.Fatalf(`%s reports version %q`, algorithm, version)
+		}
+	}
+	if _, err := chunkers.Version("unknown"); err == nil {
+		t.Fatalf(`unknown algorithm was accepted`)
+	}
+}
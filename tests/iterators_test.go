@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"testing/iotest"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func Test_All(t *testing.T) {
+	data := rb[:32<<20]
+
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			expected := boundaries(t, algorithm, data, nil)
+
+			chunker, err := chunkers.NewChunker(algorithm, bytes.NewReader(data), nil)
+			if err != nil {
+				t.Fatalf(`chunker error: %s`, err)
+			}
+
+			var cuts []int
+			for chunk, err := range chunker.All() {
+				if err != nil {
+					t.Fatalf(`chunker error: %s`, err)
+				}
+				if !bytes.Equal(chunk.Data, data[chunk.Offset:int(chunk.Offset)+len(chunk.Data)]) {
+					t.Fatalf(`chunker produces incorrect output at offset %d`, chunk.Offset)
+				}
+				cuts = append(cuts, int(chunk.Offset)+len(chunk.Data))
+			}
+			if !equalBoundaries(expected, cuts) {
+				t.Fatalf(`All produced %d chunks, Next produced %d`, len(cuts), len(expected))
+			}
+
+			chunker.Reset(bytes.NewReader(data))
+			cuts = cuts[:0]
+			for record, err := range chunker.AllRecords(sha256.New) {
+				if err != nil {
+					t.Fatalf(`chunker error: %s`, err)
+				}
+				sum := sha256.Sum256(data[record.Offset : record.Offset+uint64(record.Length)])
+				if !bytes.Equal(record.Digest, sum[:]) {
+					t.Fatalf(`record at offset %d has an incorrect digest`, record.Offset)
+				}
+				cuts = append(cuts, int(record.Offset)+int(record.Length))
+			}
+			if !equalBoundaries(expected, cuts) {
+				t.Fatalf(`AllRecords produced %d records, Next produced %d chunks`, len(cuts), len(expected))
+			}
+		})
+	}
+}
+
+func Test_All_Break(t *testing.T) {
+	chunker, err := chunkers.NewChunker("fastcdc", bytes.NewReader(rb[:32<<20]), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	n := 0
+	for _, err := range chunker.All() {
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Fatalf(`iteration did not stop on break`)
+	}
+}
+
+func Test_All_Error(t *testing.T) {
+	errRead := errors.New("read error")
+	chunker, err := chunkers.NewChunker("fastcdc", iotest.ErrReader(errRead), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	n := 0
+	for _, err := range chunker.All() {
+		if !errors.Is(err, errRead) {
+			t.Fatalf(`expected the reader error, got %v`, err)
+		}
+		n++
+	}
+	if n != 1 {
+		t.Fatalf(`expected a single error, got %d iterations`, n)
+	}
+}
@@ -0,0 +1,37 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+// jcExpectedMean returns the mean chunk size of the JC paper: chunks end on
+// every other jump point on average, so they are MinSize plus the bytes
+// scanned for two jump points plus one jump.
+func jcExpectedMean(opts *chunkers.ChunkerOpts) float64 {
+	cOnes := int(math.Log2(float64(opts.NormalSize))) - 1
+	jOnes := cOnes - 1
+	jumpLength := ((1 << jOnes) * cOnes) / ((1 << cOnes) - (1 << jOnes))
+	return float64(opts.MinSize + 1<<cOnes + jumpLength)
+}
+
+// Test_JC_NormalSize checks that the masks and jump length follow NormalSize,
+// so that the mean chunk size is the expected one.
+func Test_JC_NormalSize(t *testing.T) {
+	data := rb[:64<<20]
+
+	for _, normalSize := range []int{4 << 10, 8 << 10, 16 << 10, 64 << 10} {
+		opts := &chunkers.ChunkerOpts{
+			MinSize:    normalSize / 4,
+			NormalSize: normalSize,
+			MaxSize:    normalSize * 8,
+		}
+		mean := meanChunkSize(t, "jc", data, opts)
+		expected := jcExpectedMean(opts)
+		if math.Abs(mean-expected) > 0.03*expected {
+			t.Fatalf(`mean chunk size is %.0f for NormalSize %d, expected %.0f`, mean, normalSize, expected)
+		}
+	}
+}
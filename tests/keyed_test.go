@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func boundaries(t *testing.T, algorithm string, data []byte, opts *chunkers.ChunkerOpts) []int {
+	chunker, err := chunkers.NewChunker(algorithm, bytes.NewReader(data), opts)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	var cuts []int
+	offset := 0
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		offset += len(chunk)
+		cuts = append(cuts, offset)
+	}
+	if offset != len(data) {
+		t.Fatalf(`chunker produced %d bytes out of %d`, offset, len(data))
+	}
+	return cuts
+}
+
+func keyedOptions(algorithm string, key byte) *chunkers.ChunkerOpts {
+	chunker, _ := chunkers.NewChunker(algorithm, bytes.NewReader(nil), nil)
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    chunker.MinSize(),
+		MaxSize:    chunker.MaxSize(),
+		NormalSize: chunker.NormalSize(),
+	}
+	if key != 0 {
+		opts.Key = bytes.Repeat([]byte{key}, chunkers.KeySize)
+	}
+	return opts
+}
+
+func Test_Keyed(t *testing.T) {
+	data := rb[:16<<20]
+
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			unkeyed := boundaries(t, algorithm, data, keyedOptions(algorithm, 0))
+			keyA := boundaries(t, algorithm, data, keyedOptions(algorithm, 'A'))
+			keyA2 := boundaries(t, algorithm, data, keyedOptions(algorithm, 'A'))
+			keyB := boundaries(t, algorithm, data, keyedOptions(algorithm, 'B'))
+
+			if !equalBoundaries(keyA, keyA2) {
+				t.Fatalf(`identical keys produced different boundaries`)
+			}
+			if equalBoundaries(keyA, keyB) {
+				t.Fatalf(`different keys produced identical boundaries`)
+			}
+			if equalBoundaries(unkeyed, keyA) {
+				t.Fatalf(`keyed chunker produced the same boundaries as the unkeyed one`)
+			}
+		})
+	}
+}
+
+func Test_Keyed_Unkeyed(t *testing.T) {
+	data := rb[:16<<20]
+
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			if !equalBoundaries(boundaries(t, algorithm, data, nil), boundaries(t, algorithm, data, keyedOptions(algorithm, 0))) {
+				t.Fatalf(`an empty key changed the boundaries`)
+			}
+		})
+	}
+}
+
+func equalBoundaries(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
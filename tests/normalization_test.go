@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/fastcdc"
+)
+
+func meanChunkSize(t *testing.T, algorithm string, data []byte, opts *chunkers.ChunkerOpts) float64 {
+	return float64(len(data)) / float64(len(boundaries(t, algorithm, data, opts)))
+}
+
+// Test_NormalizationLevel checks that the mean chunk size follows NormalSize
+// on random data, at every normalization level.
+func Test_NormalizationLevel(t *testing.T) {
+	data := rb[:64<<20]
+
+	for _, algorithm := range []string{"fastcdc", "fastcdc-v2020"} {
+		for _, normalSize := range []int{4 << 10, 16 << 10, 64 << 10} {
+			for _, level := range []int{chunkers.NormalizationNone, 1, 2, 3} {
+				opts := &chunkers.ChunkerOpts{
+					MinSize:            normalSize / 4,
+					NormalSize:         normalSize,
+					MaxSize:            normalSize * 8,
+					NormalizationLevel: level,
+				}
+				mean := meanChunkSize(t, algorithm, data, opts)
+				if math.Abs(mean-float64(normalSize)) > 0.3*float64(normalSize) {
+					t.Fatalf(`%s: mean chunk size is %.0f for NormalSize %d at level %d`, algorithm, mean, normalSize, level)
+				}
+			}
+		}
+	}
+}
+
+// Test_NormalizationLevel_Default checks that a zero NormalizationLevel
+// selects the default level and that NormalizationNone differs from it.
+func Test_NormalizationLevel_Default(t *testing.T) {
+	data := rb[:4<<20]
+
+	for _, algorithm := range []string{"fastcdc", "fastcdc-v2020"} {
+		defaults := boundaries(t, algorithm, data, nil)
+		explicit := boundaries(t, algorithm, data, &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10})
+		if !equalBoundaries(defaults, explicit) {
+			t.Fatalf(`%s: options equal to the defaults produce different boundaries`, algorithm)
+		}
+
+		none, err := chunkers.NewOptions(algorithm, fastcdc.WithNormalization(0))
+		if err != nil {
+			t.Fatalf(`%s: options error: %s`, algorithm, err)
+		}
+		if none.NormalizationLevel != chunkers.NormalizationNone {
+			t.Fatalf(`%s: WithNormalization(0) selected level %d`, algorithm, none.NormalizationLevel)
+		}
+		if equalBoundaries(defaults, boundaries(t, algorithm, data, none)) {
+			t.Fatalf(`%s: NormalizationNone produces the default boundaries`, algorithm)
+		}
+	}
+}
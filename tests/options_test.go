@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/buzhash"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/fastcdc"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/rabin"
+)
+
+func Test_ValidateOptions(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		opts      *chunkers.ChunkerOpts
+		field     string
+	}{
+		{"buzhash", nil, ""},
+		{"buzhash", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, WindowSize: -1}, "WindowSize"},
+		{"buzhash", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 4 << 10, NormalSize: 8 << 10, WindowSize: 4095}, "MaxSize"},
+		{"buzhash", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 6 << 10}, "NormalSize"},
+		{"fastcdc", nil, ""},
+		{"fastcdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10}, "NormalSize"},
+		{"fastcdc", &chunkers.ChunkerOpts{MinSize: 16 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10}, "MinSize"},
+		{"fastcdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 4 << 10, NormalSize: 8 << 10}, "MaxSize"},
+		{"fastcdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, Key: []byte("short")}, "Key"},
+		{"fastcdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, NormalizationLevel: 4}, "NormalizationLevel"},
+		{"fastcdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, NormalizationLevel: -2}, "NormalizationLevel"},
+		{"jc", nil, ""},
+		{"jc", &chunkers.ChunkerOpts{MinSize: 32, MaxSize: 64 << 10, NormalSize: 8 << 10}, "MinSize"},
+		{"jc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 2 << 30, NormalSize: 8 << 10}, "MaxSize"},
+		{"rabin", nil, ""},
+		{"rabin", &chunkers.ChunkerOpts{MinSize: 32, MaxSize: 64 << 10, NormalSize: 8 << 10}, "MinSize"},
+		{"rabin", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 1 << 10, NormalSize: 8 << 10}, "MaxSize"},
+		{"rabin", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 6 << 10}, "NormalSize"},
+		{"rabin", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, Polynomial: 0x11B}, "Polynomial"},
+		{"rabin", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, Polynomial: 0x3DA3358B4DC172}, "Polynomial"},
+		{"rabin", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, Polynomial: 0x3DA3358B4DC173, Key: make([]byte, chunkers.KeySize)}, "Polynomial"},
+		{"ultracdc", nil, ""},
+		{"ultracdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10}, ""},
+		{"ultracdc", &chunkers.ChunkerOpts{MinSize: 64, MaxSize: 65}, "MaxSize"},
+		{"ultracdc", &chunkers.ChunkerOpts{MinSize: 10, MaxSize: 64 << 10}, "MinSize"},
+		{"ultracdc", &chunkers.ChunkerOpts{MinSize: 16 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10}, "MinSize"},
+		{"ultracdc", &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 4 << 10, NormalSize: 8 << 10}, "MaxSize"},
+	}
+
+	for _, test := range tests {
+		err := chunkers.ValidateOptions(test.algorithm, test.opts)
+		if test.field == "" {
+			if err != nil {
+				t.Fatalf(`%s: unexpected error: %s`, test.algorithm, err)
+			}
+			continue
+		}
+
+		var optionsError *chunkers.OptionsError
+		if !errors.As(err, &optionsError) {
+			t.Fatalf(`%s: expected an OptionsError for %s, got %v`, test.algorithm, test.field, err)
+		}
+		if optionsError.Field != test.field {
+			t.Fatalf(`%s: expected an OptionsError for %s, got %s`, test.algorithm, test.field, optionsError.Field)
+		}
+		if optionsError.Min > optionsError.Max {
+			t.Fatalf(`%s: OptionsError for %s has an empty range: %s`, test.algorithm, test.field, err)
+		}
+
+		if _, err := chunkers.NewChunker(test.algorithm, bytes.NewReader(nil), test.opts); !errors.As(err, &optionsError) {
+			t.Fatalf(`%s: NewChunker accepted invalid %s`, test.algorithm, test.field)
+		}
+	}
+}
+
+func Test_ValidateOptions_UnknownAlgorithm(t *testing.T) {
+	if err := chunkers.ValidateOptions("unknown", nil); err == nil {
+		t.Fatalf(`unknown algorithm was accepted`)
+	}
+}
+
+func Test_FunctionalOptions(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		options   []chunkers.Option
+		expected  *chunkers.ChunkerOpts
+	}{
+		{"fastcdc", nil, &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, NormalizationLevel: 2}},
+		{"fastcdc", []chunkers.Option{chunkers.WithMinSize(4 << 10), fastcdc.WithNormalization(3)},
+			&chunkers.ChunkerOpts{MinSize: 4 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10, NormalizationLevel: 3}},
+		{"fastcdc", []chunkers.Option{&chunkers.ChunkerOpts{MinSize: 1 << 10, MaxSize: 32 << 10, NormalSize: 4 << 10}, chunkers.WithMaxSize(16 << 10)},
+			&chunkers.ChunkerOpts{MinSize: 1 << 10, MaxSize: 16 << 10, NormalSize: 4 << 10}},
+		{"rabin", []chunkers.Option{chunkers.WithNormalSize(256 << 10), rabin.WithPolynomial(0x3DA3358B4DC173)},
+			&chunkers.ChunkerOpts{MinSize: 512 << 10, MaxSize: 8 << 20, NormalSize: 256 << 10, Polynomial: 0x3DA3358B4DC173}},
+		{"buzhash", []chunkers.Option{buzhash.WithWindowSize(48), buzhash.WithSeed(42)},
+			&chunkers.ChunkerOpts{MinSize: 512 << 10, MaxSize: 8 << 20, NormalSize: 2 << 20, WindowSize: 48, Seed: 42}},
+	}
+
+	for _, test := range tests {
+		opts, err := chunkers.NewOptions(test.algorithm, test.options...)
+		if err != nil {
+			t.Fatalf(`%s: options error: %s`, test.algorithm, err)
+		}
+		if !reflect.DeepEqual(opts, test.expected) {
+			t.Fatalf(`%s: got options %+v, expected %+v`, test.algorithm, opts, test.expected)
+		}
+
+		chunker, err := chunkers.NewChunker(test.algorithm, bytes.NewReader(nil), test.options...)
+		if err != nil {
+			t.Fatalf(`%s: chunker error: %s`, test.algorithm, err)
+		}
+		if chunker.MinSize() != opts.MinSize || chunker.MaxSize() != opts.MaxSize || chunker.NormalSize() != opts.NormalSize {
+			t.Fatalf(`%s: chunker doesn't use the functional options`, test.algorithm)
+		}
+	}
+}
+
+func Test_FunctionalOptions_Boundaries(t *testing.T) {
+	data := rb[:4<<20]
+	opts := &chunkers.ChunkerOpts{MinSize: 4 << 10, MaxSize: 64 << 10, NormalSize: 16 << 10, NormalizationLevel: 1}
+
+	functional, err := chunkers.NewOptions("fastcdc", chunkers.WithMinSize(4<<10), chunkers.WithNormalSize(16<<10), fastcdc.WithNormalization(1))
+	if err != nil {
+		t.Fatalf(`options error: %s`, err)
+	}
+	if !equalBoundaries(boundaries(t, "fastcdc", data, opts), boundaries(t, "fastcdc", data, functional)) {
+		t.Fatalf(`functional options and ChunkerOpts produce different boundaries`)
+	}
+}
+
+func Test_FunctionalOptions_Copy(t *testing.T) {
+	opts := &chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10, NormalSize: 8 << 10}
+	chunker, err := chunkers.NewChunker("fastcdc", bytes.NewReader(nil), opts)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	opts.MinSize = 4 << 10
+	if chunker.MinSize() != 2<<10 {
+		t.Fatalf(`chunker shares its options with the caller`)
+	}
+
+	var nilOpts *chunkers.ChunkerOpts
+	for _, options := range [][]chunkers.Option{nil, {nil}, {nilOpts}} {
+		chunker, err := chunkers.NewChunker("fastcdc", bytes.NewReader(nil), options...)
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		if chunker.NormalizationLevel() != 2 {
+			t.Fatalf(`nil options don't select the defaults`)
+		}
+	}
+}
+
+func Test_WithExtra(t *testing.T) {
+	first := chunkers.WithExtra("a", 1)
+	opts, _ := chunkers.NewOptions("fastcdc", first, chunkers.WithExtra("b", "two"))
+	if !reflect.DeepEqual(opts.Extra, map[string]any{"a": 1, "b": "two"}) {
+		t.Fatalf(`got extra %v`, opts.Extra)
+	}
+	again, _ := chunkers.NewOptions("fastcdc", first)
+	if len(again.Extra) != 1 {
+		t.Fatalf(`WithExtra shares its map between options`)
+	}
+
+	var pool chunkers.Pool
+	chunker, err := pool.Get("fastcdc", bytes.NewReader(nil), opts)
+	if err != nil {
+		t.Fatalf(`pool error: %s`, err)
+	}
+	pool.Put(chunker)
+	if reused, _ := pool.Get("fastcdc", bytes.NewReader(nil), again); reused == chunker {
+		t.Fatalf(`pool reused a chunker with different extra options`)
+	}
+}
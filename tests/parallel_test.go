@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/fastcdc"
+)
+
+func Test_ParallelChunker(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		data      []byte
+		opts      *chunkers.ChunkerOpts
+		workers   int
+	}{
+		{"fastcdc", rb, nil, 4},
+		{"jc", rb, nil, 4},
+		{"ultracdc", rb, nil, 4},
+		{"fastcdc", rb[:64<<20], &chunkers.ChunkerOpts{MinSize: minSize, NormalSize: avgSize, MaxSize: maxSize}, 3},
+		{"jc", rb[:64<<20], &chunkers.ChunkerOpts{MinSize: minSize, NormalSize: avgSize, MaxSize: maxSize}, 3},
+		{"ultracdc", rb[:64<<20], &chunkers.ChunkerOpts{MinSize: minSize, NormalSize: minSize + (8 << 10), MaxSize: maxSize}, 3},
+		{"rabin", rb[:64<<20], nil, 4},
+		{"buzhash", rb[:64<<20], nil, 4},
+		{"fastcdc", rb[:(8<<20)+12345], nil, 1},
+		{"fastcdc", rb[:100], nil, 2},
+		{"fastcdc", rb[:0], nil, 2},
+	}
+
+	for _, test := range tests {
+		name := fmt.Sprintf("%s/%d/%d", test.algorithm, len(test.data), test.workers)
+		t.Run(name, func(t *testing.T) {
+			expected := boundaries(t, test.algorithm, test.data, test.opts)
+
+			chunker, err := chunkers.NewParallelChunker(test.algorithm, bytes.NewReader(test.data), int64(len(test.data)), test.workers, test.opts)
+			if err != nil {
+				t.Fatalf(`chunker error: %s`, err)
+			}
+
+			var cuts []int
+			offset := 0
+			for {
+				chunk, err := chunker.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf(`chunker error: %s`, err)
+				}
+				if !bytes.Equal(chunk, test.data[offset:offset+len(chunk)]) {
+					t.Fatalf(`chunker produces incorrect output at offset %d`, offset)
+				}
+				offset += len(chunk)
+				cuts = append(cuts, offset)
+			}
+
+			if !equalBoundaries(expected, cuts) {
+				t.Fatalf(`parallel chunker produced %d chunks, sequential chunker produced %d`, len(cuts), len(expected))
+			}
+		})
+	}
+}
+
+func Test_ParallelChunker_Options(t *testing.T) {
+	data := rb[:16<<20]
+	opts := &chunkers.ChunkerOpts{MinSize: 4 << 10, MaxSize: 64 << 10, NormalSize: 16 << 10}
+	expected := boundaries(t, "fastcdc", data, opts)
+
+	chunker, err := chunkers.NewParallelChunker("fastcdc", bytes.NewReader(data), int64(len(data)), 2, opts)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	// the chunker must not share its options with the caller
+	opts.MaxSize = 32 << 10
+
+	var cuts []int
+	offset := 0
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		offset += len(chunk)
+		cuts = append(cuts, offset)
+	}
+	if !equalBoundaries(expected, cuts) {
+		t.Fatalf(`parallel chunker doesn't use the options it was created with`)
+	}
+
+	chunker, err = chunkers.NewParallelChunker("fastcdc", bytes.NewReader(data), int64(len(data)), 2,
+		chunkers.WithMinSize(4<<10), fastcdc.WithNormalization(3))
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	if chunker.MinSize() != 4<<10 || chunker.NormalizationLevel() != 3 {
+		t.Fatalf(`parallel chunker doesn't use the functional options`)
+	}
+}
+
+func Benchmark_PlakarLabs_FastCDC_Parallel(b *testing.B) {
+	r := bytes.NewReader(rb)
+	b.SetBytes(int64(r.Len()))
+	b.ResetTimer()
+	nchunks := 0
+
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+
+	w := func(offset, length uint, chunk []byte) error {
+		nchunks++
+		return nil
+	}
+
+	for i := 0; i < b.N; i++ {
+		chunker, err := chunkers.NewParallelChunker("fastcdc", r, r.Size(), 0, opts)
+		if err != nil {
+			b.Fatalf(`chunker error: %s`, err)
+		}
+		err = chunker.Split(w)
+		if err != nil {
+			b.Fatalf(`chunker error: %s`, err)
+		}
+	}
+	b.ReportMetric(float64(nchunks)/float64(b.N), "chunks")
+}
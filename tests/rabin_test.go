@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	restic "github.com/restic/chunker"
+)
+
+func resticBoundaries(t *testing.T, data []byte, pol restic.Pol, min, max uint, averageBits int) []int {
+	chunker := restic.NewWithBoundaries(bytes.NewReader(data), pol, min, max)
+	chunker.SetAverageBits(averageBits)
+
+	var cuts []int
+	buffer := make([]byte, max)
+	for {
+		chunk, err := chunker.Next(buffer)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf(`restic error: %s`, err)
+		}
+		cuts = append(cuts, int(chunk.Start+chunk.Length))
+	}
+	return cuts
+}
+
+func Test_Rabin_Restic(t *testing.T) {
+	data := rb[:64<<20]
+
+	// an irreducible polynomial of restic's tests other than the default one
+	const pol = restic.Pol(0x2482734CACCA49)
+
+	tests := []struct {
+		name        string
+		pol         restic.Pol
+		opts        *chunkers.ChunkerOpts
+		averageBits int
+	}{
+		{"default", 0x3DA3358B4DC173, nil, 20},
+		{"polynomial", pol, &chunkers.ChunkerOpts{MinSize: 16 << 10, NormalSize: 64 << 10, MaxSize: 256 << 10, Polynomial: uint64(pol)}, 16},
+		{"small", pol, &chunkers.ChunkerOpts{MinSize: 64, NormalSize: 256, MaxSize: 1 << 10, Polynomial: uint64(pol)}, 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			min, max := uint(restic.MinSize), uint(restic.MaxSize)
+			if test.opts != nil {
+				min, max = uint(test.opts.MinSize), uint(test.opts.MaxSize)
+			}
+			expected := resticBoundaries(t, data, test.pol, min, max, test.averageBits)
+			if !equalBoundaries(boundaries(t, "rabin", data, test.opts), expected) {
+				t.Fatalf(`boundaries differ from restic with polynomial %s`, test.pol)
+			}
+		})
+	}
+}
+
+func Test_Rabin_Polynomial(t *testing.T) {
+	tests := []struct {
+		pol   uint64
+		valid bool
+	}{
+		{0x3DA3358B4DC173, true},
+		{0x3DA3358B4DC172, false},
+		{0x3DA3358B4DC173 << 2, false},
+		{0x11B, false},
+	}
+
+	for _, test := range tests {
+		opts := &chunkers.ChunkerOpts{MinSize: 2 << 10, NormalSize: 8 << 10, MaxSize: 64 << 10, Polynomial: test.pol}
+		if err := chunkers.ValidateOptions("rabin", opts); (err == nil) != test.valid {
+			t.Fatalf(`polynomial %#x: got %v`, test.pol, err)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 256; i++ {
+		pol := rnd.Uint64()&(1<<53-1) | 1<<53
+		opts := &chunkers.ChunkerOpts{MinSize: 2 << 10, NormalSize: 8 << 10, MaxSize: 64 << 10, Polynomial: pol}
+		if valid := chunkers.ValidateOptions("rabin", opts) == nil; valid != restic.Pol(pol).Irreducible() {
+			t.Fatalf(`polynomial %#x: irreducibility differs from restic`, pol)
+		}
+	}
+
+	opts := &chunkers.ChunkerOpts{MinSize: 2 << 10, NormalSize: 8 << 10, MaxSize: 64 << 10, Polynomial: 0x3DA3358B4DC173, Key: bytes.Repeat([]byte{'A'}, chunkers.KeySize)}
+	if err := chunkers.ValidateOptions("rabin", opts); err == nil {
+		t.Fatalf(`Polynomial was accepted along with a Key`)
+	}
+}
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+func checkRecords(t *testing.T, records *chunkers.Records, data []byte, expected []int) {
+	offset := 0
+	for i := 0; ; i++ {
+		record, err := records.Next()
+		if err == io.EOF {
+			if i != len(expected) {
+				t.Fatalf(`got %d records, expected %d`, i, len(expected))
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		if i >= len(expected) {
+			t.Fatalf(`got more than %d records`, len(expected))
+		}
+
+		if record.Offset != uint64(offset) || int(record.Offset)+int(record.Length) != expected[i] {
+			t.Fatalf(`record %d is at %d+%d, expected %d-%d`, i, record.Offset, record.Length, offset, expected[i])
+		}
+		sum := sha256.Sum256(data[offset:expected[i]])
+		if !bytes.Equal(record.Digest, sum[:]) {
+			t.Fatalf(`record %d has an incorrect digest`, i)
+		}
+		offset = expected[i]
+	}
+
+	if _, err := records.Next(); err != io.EOF {
+		t.Fatalf(`records did not stay at io.EOF`)
+	}
+}
+
+func Test_Records(t *testing.T) {
+	data := rb[:32<<20]
+
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			expected := boundaries(t, algorithm, data, nil)
+
+			chunker, err := chunkers.NewChunker(algorithm, bytes.NewReader(data), nil)
+			if err != nil {
+				t.Fatalf(`chunker error: %s`, err)
+			}
+			checkRecords(t, chunker.Records(sha256.New), data, expected)
+
+			chunker.Reset(bytes.NewReader(data))
+			records := chunker.PipelinedRecords(sha256.New, 4)
+			defer records.Close()
+			checkRecords(t, records, data, expected)
+		})
+	}
+}
+
+func Test_Records_Close(t *testing.T) {
+	chunker, err := chunkers.NewChunker("fastcdc", bytes.NewReader(rb[:32<<20]), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	records := chunker.PipelinedRecords(sha256.New, 2)
+	if _, err := records.Next(); err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	records.Close()
+	if _, err := records.Next(); err != io.EOF {
+		t.Fatalf(`records did not stop after Close`)
+	}
+}
+
+func Test_Records_CloseReset(t *testing.T) {
+	data := rb[:8<<20]
+	expected := boundaries(t, "fastcdc", data, nil)
+
+	chunker, err := chunkers.NewChunker("fastcdc", bytes.NewReader(rb[:32<<20]), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+
+	for i := 0; i < 16; i++ {
+		records := chunker.PipelinedRecords(sha256.New, 2)
+		if _, err := records.Next(); err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		records.Close()
+		records.Close()
+
+		// the producer must have stopped using the chunker once Close returns
+		chunker.Reset(bytes.NewReader(data))
+		checkRecords(t, chunker.Records(sha256.New), data, expected)
+		chunker.Reset(bytes.NewReader(rb[:32<<20]))
+	}
+}
+
+func Benchmark_PlakarLabs_FastCDC_Records(b *testing.B) {
+	r := bytes.NewReader(rb)
+	b.SetBytes(int64(r.Len()))
+	b.ResetTimer()
+	nchunks := 0
+
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+
+	for i := 0; i < b.N; i++ {
+		chunker, err := chunkers.NewChunker("fastcdc", r, opts)
+		if err != nil {
+			b.Fatalf(`chunker error: %s`, err)
+		}
+		records := chunker.Records(sha256.New)
+		for _, err := records.Next(); err == nil; _, err = records.Next() {
+			nchunks++
+		}
+		r.Reset(rb)
+	}
+	b.ReportMetric(float64(nchunks)/float64(b.N), "chunks")
+}
+
+func Benchmark_PlakarLabs_FastCDC_PipelinedRecords(b *testing.B) {
+	r := bytes.NewReader(rb)
+	b.SetBytes(int64(r.Len()))
+	b.ResetTimer()
+	nchunks := 0
+
+	opts := &chunkers.ChunkerOpts{
+		MinSize:    minSize,
+		NormalSize: avgSize,
+		MaxSize:    maxSize,
+	}
+
+	for i := 0; i < b.N; i++ {
+		chunker, err := chunkers.NewChunker("fastcdc", r, opts)
+		if err != nil {
+			b.Fatalf(`chunker error: %s`, err)
+		}
+		records := chunker.PipelinedRecords(sha256.New, 4)
+		for _, err := records.Next(); err == nil; _, err = records.Next() {
+			nchunks++
+		}
+		records.Close()
+		r.Reset(rb)
+	}
+	b.ReportMetric(float64(nchunks)/float64(b.N), "chunks")
+}
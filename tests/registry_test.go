@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+// fixed cuts chunks of NormalSize bytes.
+type fixed struct{}
+
+func newFixed() chunkers.ChunkerImplementation {
+	return &fixed{}
+}
+
+func (c *fixed) DefaultOptions() *chunkers.ChunkerOpts {
+	return &chunkers.ChunkerOpts{MinSize: 1, MaxSize: 1024, NormalSize: 512}
+}
+
+func (c *fixed) Validate(options *chunkers.ChunkerOpts) error {
+	return nil
+}
+
+func (c *fixed) Algorithm(options *chunkers.ChunkerOpts, data []byte, n int) int {
+	return min(n, options.NormalSize)
+}
+
+func Test_Registry(t *testing.T) {
+	var registry chunkers.Registry
+
+	if names := registry.Algorithms(); len(names) != 0 {
+		t.Fatalf(`empty registry lists %v`, names)
+	}
+	if err := registry.Register("fixed", newFixed); err != nil {
+		t.Fatalf(`register error: %s`, err)
+	}
+	if err := registry.Register("fixed", newFixed); !errors.Is(err, chunkers.ErrAlreadyRegistered) {
+		t.Fatalf(`got error %v, expected ErrAlreadyRegistered`, err)
+	}
+	if names := registry.Algorithms(); !slices.Equal(names, []string{"fixed"}) {
+		t.Fatalf(`registry lists %v`, names)
+	}
+	if slices.Contains(chunkers.Algorithms(), "fixed") {
+		t.Fatalf(`registering in a registry modified DefaultRegistry`)
+	}
+	if _, err := registry.NewChunker("fastcdc", bytes.NewReader(nil), nil); !errors.Is(err, chunkers.ErrUnknownAlgorithm) {
+		t.Fatalf(`got error %v, expected ErrUnknownAlgorithm`, err)
+	}
+
+	chunker, err := registry.NewChunker("fixed", bytes.NewReader(make([]byte, 2048)), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	if chunk, _ := chunker.Next(); len(chunk) != 512 {
+		t.Fatalf(`got a chunk of %d bytes, expected 512`, len(chunk))
+	}
+
+	pool := chunkers.Pool{Registry: &registry}
+	if _, err := pool.Get("fixed", bytes.NewReader(nil), nil); err != nil {
+		t.Fatalf(`pool error: %s`, err)
+	}
+
+	if err := registry.Unregister("fixed"); err != nil {
+		t.Fatalf(`unregister error: %s`, err)
+	}
+	if err := registry.Unregister("fixed"); !errors.Is(err, chunkers.ErrUnknownAlgorithm) {
+		t.Fatalf(`got error %v, expected ErrUnknownAlgorithm`, err)
+	}
+	for _, err := range []error{
+		registry.ValidateOptions("fixed", nil),
+		func() error { _, err := registry.Version("fixed"); return err }(),
+		func() error { _, err := registry.Describe("fixed"); return err }(),
+		func() error {
+			_, err := registry.NewParallelChunker("fixed", bytes.NewReader(nil), 0, 1)
+			return err
+		}(),
+	} {
+		if !errors.Is(err, chunkers.ErrUnknownAlgorithm) {
+			t.Fatalf(`got error %v, expected ErrUnknownAlgorithm`, err)
+		}
+	}
+}
+
+func Test_Registry_Override(t *testing.T) {
+	var registry chunkers.Registry
+	registry.Override("fastcdc", newFixed)
+
+	chunker, err := registry.NewChunker("fastcdc", bytes.NewReader(make([]byte, 2048)), nil)
+	if err != nil {
+		t.Fatalf(`chunker error: %s`, err)
+	}
+	if chunk, _ := chunker.Next(); len(chunk) != 512 {
+		t.Fatalf(`overridden algorithm was not used`)
+	}
+	if chunker, _ := chunkers.NewChunker("fastcdc", bytes.NewReader(make([]byte, 2048)), nil); chunker.NormalSize() == 512 {
+		t.Fatalf(`overriding in a registry modified DefaultRegistry`)
+	}
+}
+
+func Test_Registry_MustRegister(t *testing.T) {
+	var registry chunkers.Registry
+	registry.MustRegister("fixed", newFixed)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf(`registering a duplicate did not panic`)
+		}
+	}()
+	registry.MustRegister("fixed", newFixed)
+}
+
+func Test_Registry_Concurrent(t *testing.T) {
+	var registry chunkers.Registry
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			name := fmt.Sprintf("fixed-%d", i)
+			for j := 0; j < 100; j++ {
+				registry.Override(name, newFixed)
+				if _, err := registry.Version(name); err != nil {
+					t.Errorf(`version error: %s`, err)
+					return
+				}
+				registry.Algorithms()
+				registry.Unregister(name)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if names := registry.Algorithms(); len(names) != 0 {
+		t.Fatalf(`registry lists %v`, names)
+	}
+}
+
+func Test_DefaultRegistry(t *testing.T) {
+	if err := chunkers.Register("fastcdc", newFixed); !errors.Is(err, chunkers.ErrAlreadyRegistered) {
+		t.Fatalf(`got error %v, expected ErrAlreadyRegistered`, err)
+	}
+	if _, err := chunkers.NewChunker("unknown", bytes.NewReader(nil), nil); !errors.Is(err, chunkers.ErrUnknownAlgorithm) {
+		t.Fatalf(`got error %v, expected ErrUnknownAlgorithm`, err)
+	}
+}
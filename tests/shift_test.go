@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"math/rand"
+	"slices"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+)
+
+// splice copies data with n edits at random positions: each edit replaces the
+// skip bytes found at its position with replacement.
+func splice(data []byte, seed int64, n int, edit func(rnd *rand.Rand) (replacement []byte, skip int)) []byte {
+	rnd := rand.New(rand.NewSource(seed))
+	positions := make([]int, n)
+	for i := range positions {
+		positions[i] = rnd.Intn(len(data))
+	}
+	slices.Sort(positions)
+
+	edited := make([]byte, 0, len(data))
+	previous := 0
+	for _, position := range positions {
+		position = max(position, previous)
+		replacement, skip := edit(rnd)
+		edited = append(edited, data[previous:position]...)
+		edited = append(edited, replacement...)
+		previous = min(position+skip, len(data))
+	}
+	return append(edited, data[previous:]...)
+}
+
+func randomBytes(rnd *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	rnd.Read(b)
+	return b
+}
+
+// shiftWorkloads edit a base buffer the way files usually change between two
+// backups, the same edits are applied on every run.
+var shiftWorkloads = []struct {
+	name string
+	edit func(data []byte) []byte
+}{
+	{"Insert", func(data []byte) []byte {
+		return splice(data, 1, 16, func(rnd *rand.Rand) ([]byte, int) {
+			return randomBytes(rnd, 1+rnd.Intn(8)), 0
+		})
+	}},
+	{"Delete", func(data []byte) []byte {
+		return splice(data, 2, 16, func(rnd *rand.Rand) ([]byte, int) {
+			return nil, 1 + rnd.Intn(4096)
+		})
+	}},
+	{"Modify", func(data []byte) []byte {
+		return splice(data, 3, 16, func(rnd *rand.Rand) ([]byte, int) {
+			n := 1 + rnd.Intn(64)
+			return randomBytes(rnd, n), n
+		})
+	}},
+	{"Append", func(data []byte) []byte {
+		return append(slices.Clip(data), randomBytes(rand.New(rand.NewSource(4)), 1<<20)...)
+	}},
+	{"Prepend", func(data []byte) []byte {
+		return append(randomBytes(rand.New(rand.NewSource(5)), 100), data...)
+	}},
+}
+
+// chunkDigests returns the length of the chunks of data by their digest.
+func chunkDigests(tb testing.TB, algorithm string, data []byte, opts *chunkers.ChunkerOpts) map[[sha256.Size]byte]int {
+	chunker, err := chunkers.NewChunker(algorithm, bytes.NewReader(data), opts)
+	if err != nil {
+		tb.Fatalf(`chunker error: %s`, err)
+	}
+
+	digests := make(map[[sha256.Size]byte]int)
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			return digests
+		}
+		if err != nil {
+			tb.Fatalf(`chunker error: %s`, err)
+		}
+		digests[sha256.Sum256(chunk)] = len(chunk)
+	}
+}
+
+// Benchmark_BoundaryShift re-chunks an edited copy of a base buffer with each
+// algorithm and reports the fraction of the original chunks, and of the bytes
+// they cover, found again after the edits. All algorithms use the same sizes
+// so that their results can be compared.
+func Benchmark_BoundaryShift(b *testing.B) {
+	base := rb[:64<<20]
+
+	for _, algorithm := range algorithms {
+		opts, err := chunkers.NewOptions(algorithm, chunkers.WithMinSize(2<<10), chunkers.WithNormalSize(8<<10), chunkers.WithMaxSize(64<<10))
+		if err != nil {
+			b.Fatalf(`options error: %s`, err)
+		}
+
+		original := chunkDigests(b, algorithm, base, opts)
+		for _, workload := range shiftWorkloads {
+			edited := workload.edit(base)
+			b.Run(algorithm+"/"+workload.name, func(b *testing.B) {
+				b.SetBytes(int64(len(edited)))
+				b.ResetTimer()
+
+				var digests map[[sha256.Size]byte]int
+				for i := 0; i < b.N; i++ {
+					digests = chunkDigests(b, algorithm, edited, opts)
+				}
+				b.StopTimer()
+
+				reusedChunks, reusedBytes, totalBytes := 0, 0, 0
+				for digest, length := range original {
+					totalBytes += length
+					if _, exists := digests[digest]; exists {
+						reusedChunks++
+						reusedBytes += length
+					}
+				}
+				b.ReportMetric(100*float64(reusedChunks)/float64(len(original)), "%chunks-reused")
+				b.ReportMetric(100*float64(reusedBytes)/float64(totalBytes), "%bytes-reused")
+			})
+		}
+	}
+}
@@ -0,0 +1,66 @@
+#!/usr/bin/env python3
+#
+# Generates the borg reference data for the buzhash algorithm, it must be run
+# with borg installed (pip install borgbackup):
+#
+#   python3 tests/testdata/borg/generate.py table > chunkers/buzhash/buzhash_precomputed.go
+#   python3 tests/testdata/borg/generate.py vectors > tests/testdata/borg/buzhash.txt
+#
+# The table is recovered from borg itself: the buzhash of a single byte b with
+# a zero seed is table_base[b]. The vectors are the boundaries borg produces
+# for inputs that tests/buzhash_test.go regenerates with borgData.
+
+import hashlib
+import io
+import struct
+import sys
+
+import borg
+from borg.chunker import Chunker, buzhash
+
+# chunk_min_exp, chunk_max_exp, hash_mask_bits, hash_window_size, seed, data
+# seed, size
+CASES = [
+    (19, 23, 21, 4095, 0, 1, 32 << 20),
+    (10, 16, 12, 4095, 0, 2, 4 << 20),
+    (10, 16, 12, 255, 0x12345678, 3, 4 << 20),
+    (12, 18, 14, 63, 0xdeadbeef, 4, 4 << 20),
+]
+
+
+def data(seed, size):
+    out = bytearray()
+    counter = 0
+    while len(out) < size:
+        out += hashlib.sha256(struct.pack("<QQ", seed, counter)).digest()
+        counter += 1
+    return bytes(out[:size])
+
+
+def table():
+    print("package buzhash")
+    print()
+    print("// tableBase is the table_base of borg's _chunker.c, the table of a chunker is")
+    print("// tableBase with each entry XOR'd with its seed.")
+    print("var tableBase = [256]uint32{")
+    for i in range(0, 256, 4):
+        entries = ", ".join("0x%08x" % buzhash(bytes([b]), 0) for b in range(i, i + 4))
+        print("\t%s," % entries)
+    print("}")
+
+
+def vectors():
+    print("# borg %s" % borg.__version__)
+    for min_exp, max_exp, mask_bits, window, seed, data_seed, size in CASES:
+        print("# params=%d,%d,%d,%d seed=%d data=%d size=%d"
+              % (min_exp, max_exp, mask_bits, window, seed, data_seed, size))
+        chunker = Chunker(seed, min_exp, max_exp, mask_bits, window)
+        offset = 0
+        for chunk in chunker.chunkify(io.BytesIO(data(data_seed, size))):
+            length = len(getattr(chunk, "data", chunk))
+            print("%d %d" % (offset, length))
+            offset += length
+
+
+if __name__ == "__main__":
+    {"table": table, "vectors": vectors}[sys.argv[1]]()
@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"bytes"
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	chunkers "github.com/PlakarLabs/go-cdc-chunkers"
+	"github.com/PlakarLabs/go-cdc-chunkers/chunkers/ultracdc"
+)
+
+// Test_UltraCDC_NormalSize checks that the masks follow NormalSize: chunks
+// rarely end before it and mostly end shortly after it.
+func Test_UltraCDC_NormalSize(t *testing.T) {
+	data := rb[:64<<20]
+
+	for _, normalSize := range []int{4 << 10, 16 << 10, 64 << 10} {
+		opts := &chunkers.ChunkerOpts{
+			MinSize:    normalSize / 4,
+			NormalSize: normalSize,
+			MaxSize:    normalSize * 8,
+		}
+
+		cuts := boundaries(t, "ultracdc", data, opts)
+		short, offset := 0, 0
+		for _, cut := range cuts {
+			if cut-offset < normalSize {
+				short++
+			}
+			offset = cut
+		}
+
+		mean := float64(len(data)) / float64(len(cuts))
+		if mean < 0.9*float64(normalSize) || mean > 1.5*float64(normalSize) {
+			t.Fatalf(`mean chunk size is %.0f for NormalSize %d`, mean, normalSize)
+		}
+		if float64(short) > 0.5*float64(len(cuts)) {
+			t.Fatalf(`%d out of %d chunks are smaller than NormalSize %d`, short, len(cuts), normalSize)
+		}
+	}
+}
+
+// Test_UltraCDC_DerivedNormalSize checks that a zero NormalSize is derived
+// from MinSize and kept below MaxSize.
+func Test_UltraCDC_DerivedNormalSize(t *testing.T) {
+	data := rb[:4<<20]
+
+	for _, test := range []struct {
+		opts       *chunkers.ChunkerOpts
+		normalSize int
+	}{
+		{&chunkers.ChunkerOpts{MinSize: 2 << 10, MaxSize: 64 << 10}, 8 << 10},
+		{&chunkers.ChunkerOpts{MinSize: 4 << 10, MaxSize: 8 << 10}, 8<<10 - 1},
+	} {
+		derived := *test.opts
+		derived.NormalSize = test.normalSize
+		if !equalBoundaries(boundaries(t, "ultracdc", data, test.opts), boundaries(t, "ultracdc", data, &derived)) {
+			t.Fatalf(`%+v doesn't derive NormalSize %d`, *test.opts, test.normalSize)
+		}
+
+		chunker, err := chunkers.NewChunker("ultracdc", bytes.NewReader(nil), test.opts)
+		if err != nil {
+			t.Fatalf(`chunker error: %s`, err)
+		}
+		parallel, err := chunkers.NewParallelChunker("ultracdc", bytes.NewReader(nil), 0, 1, test.opts)
+		if err != nil {
+			t.Fatalf(`parallel chunker error: %s`, err)
+		}
+		if chunker.NormalSize() != test.normalSize || parallel.NormalSize() != test.normalSize || test.opts.NormalSize != 0 {
+			t.Fatalf(`chunkers report NormalSize %d and %d instead of %d`, chunker.NormalSize(), parallel.NormalSize(), test.normalSize)
+		}
+	}
+}
+
+// ultraCDCReference is UltraCDC moving its window one byte at a time, with the
+// masks of the paper for an 8KB NormalSize. Like the algorithm, it looks for
+// low entropy runs by comparing the windows at multiples of 8 bytes past
+// MinSize.
+func ultraCDCReference(opts *chunkers.ChunkerOpts, data []byte, n int) int {
+	weight := func(b byte) int { return bits.OnesCount8(b ^ 0xAA) }
+
+	normalSize := opts.NormalSize
+	switch {
+	case n <= opts.MinSize:
+		return n
+	case n >= opts.MaxSize:
+		n = opts.MaxSize
+	case n <= normalSize:
+		normalSize = n
+	}
+	if n < opts.MinSize+8 {
+		return n
+	}
+
+	dist := 0
+	for _, b := range data[opts.MinSize : opts.MinSize+8] {
+		dist += weight(b)
+	}
+
+	cnt := 0
+	for i := opts.MinSize + 8; i < n; i++ {
+		if (i-opts.MinSize)%8 == 0 && i+8 <= n {
+			if bytes.Equal(data[i:i+8], data[i-8:i]) {
+				cnt++
+				if cnt == 64 {
+					return i + 8
+				}
+				i += 7
+				continue
+			}
+			cnt = 0
+		}
+
+		mask := 0x2F
+		if i >= normalSize {
+			mask = 0x2C
+		}
+		if dist&mask == 0 {
+			return i
+		}
+		dist += weight(data[i]) - weight(data[i-8])
+	}
+	return n
+}
+
+// Test_UltraCDC_Reference checks that the algorithm, which reads its windows
+// 8 bytes at a time through bounds-checked slices, cuts where the byte at a
+// time reference does when NormalSize is a multiple of 8 bytes past MinSize.
+func Test_UltraCDC_Reference(t *testing.T) {
+	opts := &chunkers.ChunkerOpts{MinSize: 2 << 10, NormalSize: 8 << 10, MaxSize: 64 << 10}
+
+	rnd := rand.New(rand.NewSource(1))
+	random := rb[:4<<20]
+	lowEntropy := make([]byte, 4<<20)
+	for offset := 0; offset < len(lowEntropy); {
+		run := rnd.Intn(16 << 10)
+		if rnd.Intn(2) == 0 {
+			rnd.Read(lowEntropy[offset:min(offset+run, len(lowEntropy))])
+		}
+		offset += run
+	}
+
+	var c ultracdc.UltraCDC
+	for _, data := range [][]byte{random, lowEntropy} {
+		for offset := 0; offset+opts.MaxSize < len(data); {
+			n := min(rnd.Intn(opts.MaxSize+1024), len(data)-offset)
+			if n == 0 {
+				continue
+			}
+			expected := ultraCDCReference(opts, data[offset:], n)
+			if cutpoint := c.Algorithm(opts, data[offset:], n); cutpoint != expected {
+				t.Fatalf(`cutpoint %d for %d bytes at offset %d, expected %d`, cutpoint, n, offset, expected)
+			}
+			offset += expected
+		}
+	}
+}
+
+// FuzzUltraCDC checks the cutpoints of ultracdc for inputs around MinSize and
+// MaxSize, including the unaligned trailing windows, and that bytes past n
+// are never read.
+func FuzzUltraCDC(f *testing.F) {
+	opts := &chunkers.ChunkerOpts{MinSize: 64, NormalSize: 256, MaxSize: 1024}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, size := range []int{opts.MinSize, opts.NormalSize, opts.MaxSize} {
+		for n := size - 16; n <= size+16; n++ {
+			random := make([]byte, n)
+			rnd.Read(random)
+			f.Add(random)
+			f.Add(make([]byte, n))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := len(data)
+		if n == 0 || n > 2*opts.MaxSize {
+			t.Skip()
+		}
+
+		var c ultracdc.UltraCDC
+		cutpoint := c.Algorithm(opts, data, n)
+		if cutpoint < min(n, opts.MinSize) || cutpoint > min(n, opts.MaxSize) {
+			t.Fatalf(`cutpoint %d is out of bounds for %d bytes`, cutpoint, n)
+		}
+
+		for _, filler := range []byte{0x00, 0xff} {
+			padded := append(append([]byte{}, data...), bytes.Repeat([]byte{filler}, 8)...)
+			if c.Algorithm(opts, padded, n) != cutpoint {
+				t.Fatalf(`cutpoint depends on the bytes following the first %d`, n)
+			}
+		}
+	})
+}